@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/applications"
+	"github.com/loveRyujin/ResuGo/internal/generator"
+	"github.com/loveRyujin/ResuGo/internal/history"
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyCompany string
+	applyRole    string
+	applyVariant string
+	applyFormats []string
+	applyDate    string
+	applyLetter  string
+
+	applicationsStatus  string
+	applicationsCompany string
+	applicationsJSON    bool
+	updateStatus        string
+	updateNote          string
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply [input-file]",
+	Short: "Record a job application with the resume sent",
+	Long: `Record an application in the local tracker (data_dir in the config). The
+resume is snapshotted and generated in each --formats format into the
+application's own directory, together with the cover letter when --letter is
+given, so the exact files that were sent are kept.`,
+	Args: cobra.ExactArgs(1),
+	RunE: recordApplication,
+}
+
+var applicationsCmd = &cobra.Command{
+	Use:     "applications",
+	Aliases: []string{"apps"},
+	Short:   "Manage tracked job applications",
+}
+
+var applicationsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tracked applications",
+	Args:  cobra.NoArgs,
+	RunE:  listApplications,
+}
+
+var applicationsUpdateCmd = &cobra.Command{
+	Use:   "update [id]",
+	Short: "Change the status of an application",
+	Long:  `Change the status of an application: applied, interview, offer or rejected.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  updateApplication,
+}
+
+func recordApplication(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+	applied := time.Now()
+	if applyDate != "" {
+		date, err := time.ParseInLocation("2006-01-02", applyDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid --date %q (expected YYYY-MM-DD)", applyDate)
+		}
+		applied = date
+	}
+	for _, format := range applyFormats {
+		if !isFormat(format) {
+			return fmt.Errorf("unsupported output format: %s", format)
+		}
+	}
+
+	resume, err := loadResume(inputFile)
+	if err != nil {
+		return err
+	}
+	var letter *models.CoverLetter
+	if applyLetter != "" {
+		if letter, err = loadCoverLetter(applyLetter); err != nil {
+			return err
+		}
+	}
+	data, err := resumeContent(inputFile)
+	if err != nil {
+		return err
+	}
+	message := fmt.Sprintf("applied to %s (%s)", applyCompany, applyRole)
+	snapshots := history.Open(appConfig.DataDir)
+	snap, err := snapshots.Save(inputFile, data, message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	app := applications.Application{
+		Company:  applyCompany,
+		Role:     applyRole,
+		Variant:  applyVariant,
+		Applied:  applied,
+		Source:   snap.Source, // Absolute, so it resolves from any directory
+		Snapshot: snap.ID,
+	}
+	app, err = applications.Open(appConfig.DataDir).Add(app, func(dir string) ([]string, error) {
+		var files []string
+		for _, format := range applyFormats {
+			path, err := writeResume(resume, format, filepath.Join(dir, "resume"+generator.Extension(format)))
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, path)
+			if letter != nil {
+				path := filepath.Join(dir, "cover-letter"+generator.Extension(format))
+				if err := writeCoverLetter(resume, letter, format, theme(), path); err != nil {
+					return nil, err
+				}
+				files = append(files, path)
+			}
+		}
+		return files, nil
+	})
+	if err != nil {
+		// Nothing is recorded, so the snapshot would be an orphan
+		if delErr := snapshots.Delete(snap); delErr != nil {
+			logger.Debug("orphan snapshot left behind", "id", snap.ID, "error", delErr)
+		}
+		return err
+	}
+	logger.Debug("application recorded", "id", app.ID, "snapshot", snap.ID, "artifacts", app.Artifacts)
+
+	fmt.Printf("Application #%d recorded: %s, %s (snapshot %s)\n", app.ID, app.Company, app.Role, snap.ID)
+	for _, file := range app.Artifacts {
+		fmt.Printf("  %s\n", file)
+	}
+	return nil
+}
+
+func listApplications(cmd *cobra.Command, args []string) error {
+	apps, err := applications.Open(appConfig.DataDir).List()
+	if err != nil {
+		return err
+	}
+
+	var status applications.Status
+	if applicationsStatus != "" {
+		if status, err = applications.ParseStatus(applicationsStatus); err != nil {
+			return err
+		}
+	}
+	filtered := []applications.Application{}
+	for _, app := range apps {
+		if status != "" && app.Status != status {
+			continue
+		}
+		if applicationsCompany != "" && !strings.Contains(strings.ToLower(app.Company), strings.ToLower(applicationsCompany)) {
+			continue
+		}
+		filtered = append(filtered, app)
+	}
+
+	if applicationsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(filtered)
+	}
+
+	if len(filtered) == 0 {
+		fmt.Println("No applications found.")
+		return nil
+	}
+	fmt.Printf("%4s  %-10s %s %s %-10s %-9s %s\n", "ID", "DATE", padRight("COMPANY", 20), padRight("ROLE", 24), "VARIANT", "STATUS", "SNAPSHOT")
+	for _, app := range filtered {
+		fmt.Printf("%4d  %-10s %s %s %-10s %-9s %s\n", app.ID, app.Applied.Format("2006-01-02"),
+			padRight(runewidth.Truncate(app.Company, 20, "…"), 20), padRight(runewidth.Truncate(app.Role, 24, "…"), 24),
+			app.Variant, app.Status, app.Snapshot)
+	}
+	return nil
+}
+
+func updateApplication(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+	if err != nil {
+		return fmt.Errorf("invalid application ID %q", args[0])
+	}
+	status, err := applications.ParseStatus(updateStatus)
+	if err != nil {
+		return err
+	}
+
+	app, err := applications.Open(appConfig.DataDir).SetStatus(id, status, updateNote, time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Application #%d (%s, %s) is now %s\n", app.ID, app.Company, app.Role, app.Status)
+	return nil
+}
+
+// isFormat reports whether format is a supported output format
+func isFormat(format string) bool {
+	for _, f := range generator.Formats {
+		if format == f {
+			return true
+		}
+	}
+	return format == "md"
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+	rootCmd.AddCommand(applicationsCmd)
+	applicationsCmd.AddCommand(applicationsListCmd)
+	applicationsCmd.AddCommand(applicationsUpdateCmd)
+
+	applyCmd.Flags().StringVar(&applyCompany, "company", "", "Company applied to")
+	applyCmd.Flags().StringVar(&applyRole, "role", "", "Role applied for")
+	applyCmd.Flags().StringVar(&applyVariant, "variant", "", "Resume variant used, e.g. backend")
+	applyCmd.Flags().StringSliceVar(&applyFormats, "formats", []string{"pdf"}, "Formats to generate and keep (comma-separated)")
+	applyCmd.Flags().StringVar(&applyLetter, "letter", "", "Cover letter file to generate alongside the resume")
+	applyCmd.Flags().StringVar(&applyDate, "date", "", "Application date as YYYY-MM-DD (default today)")
+	applyCmd.MarkFlagRequired("company")
+	applyCmd.MarkFlagRequired("role")
+
+	applicationsListCmd.Flags().StringVar(&applicationsStatus, "status", "", "Only show applications with this status")
+	applicationsListCmd.Flags().StringVar(&applicationsCompany, "company", "", "Only show companies containing this text")
+	applicationsListCmd.Flags().BoolVar(&applicationsJSON, "json", false, "Print applications as JSON")
+
+	applicationsUpdateCmd.Flags().StringVar(&updateStatus, "status", "", "New status (applied, interview, offer, rejected)")
+	applicationsUpdateCmd.Flags().StringVar(&updateNote, "note", "", "Note stored with the status change")
+	applicationsUpdateCmd.MarkFlagRequired("status")
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/loveRyujin/ResuGo/internal/generator"
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	letterFormat string
+	letterOutput string
+	letterTheme  string
+)
+
+var coverLetterCmd = &cobra.Command{
+	Use:   "cover-letter [resume-file] [letter-file]",
+	Short: "Generate a cover letter matching the resume",
+	Long: `Render a cover letter from the resume's personal info and a per-application
+letter file (company, role, hiring manager, custom paragraphs and the
+experiences to highlight). Text in the letter file may use placeholders such
+as {{.Company}}, {{.Role}} and {{.Personal.Name}}.
+
+The letter is available in the same formats as the resume and uses the same
+theme and header, so both documents look like a set. Create a template with
+"resumgo init --with-letter".`,
+	Args: cobra.ExactArgs(2),
+	RunE: generateCoverLetter,
+}
+
+func generateCoverLetter(cmd *cobra.Command, args []string) error {
+	format := appConfig.Format
+	if cmd.Flags().Changed("format") {
+		format = letterFormat
+	}
+	if letterTheme == "" {
+		letterTheme = appConfig.Theme
+	}
+
+	resume, err := loadResume(args[0])
+	if err != nil {
+		return err
+	}
+	letter, err := loadCoverLetter(args[1])
+	if err != nil {
+		return err
+	}
+
+	path := resolveOutputPath(letterOutput, "cover-letter"+generator.Extension(format))
+	if err := writeCoverLetter(resume, letter, format, letterTheme, path); err != nil {
+		return err
+	}
+	fmt.Printf("Cover letter generated successfully: %s\n", path)
+	return nil
+}
+
+// writeCoverLetter renders the letter for resume in the given format to path
+func writeCoverLetter(resume *models.Resume, letter *models.CoverLetter, format, theme, path string) error {
+	appConfig.ApplyAuthor(&resume.PersonalInfo)
+	gen := generator.NewGenerator(resume,
+		generator.WithLocale(appConfig.Locale),
+		generator.WithPageSize(appConfig.PageSize),
+		generator.WithLogger(logger),
+	)
+	if err := gen.GenerateCoverLetter(path, letter, format, theme); err != nil {
+		return fmt.Errorf("failed to generate cover letter: %w", err)
+	}
+	return nil
+}
+
+// loadCoverLetter reads and parses a cover letter YAML file
+func loadCoverLetter(path string) (*models.CoverLetter, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cover letter: %w", err)
+	}
+	var letter models.CoverLetter
+	if err := yaml.Unmarshal(data, &letter); err != nil {
+		return nil, fmt.Errorf("failed to parse cover letter: %w", err)
+	}
+	if letter.Company == "" || letter.Role == "" {
+		return nil, fmt.Errorf("cover letter %s must set company and role", path)
+	}
+	return &letter, nil
+}
+
+func init() {
+	rootCmd.AddCommand(coverLetterCmd)
+
+	coverLetterCmd.Flags().StringVarP(&letterFormat, "format", "f", "markdown", "Output format (yaml, markdown, html, pdf)")
+	coverLetterCmd.Flags().StringVarP(&letterOutput, "output", "o", "", "Output file path")
+	coverLetterCmd.Flags().StringVarP(&letterTheme, "theme", "t", "", "Theme for HTML and PDF output (default from config)")
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/loveRyujin/ResuGo/internal/timeline"
 	"github.com/loveRyujin/ResuGo/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -10,7 +11,7 @@ var createCmd = &cobra.Command{
 	Short: "Create a new resume",
 	Long:  "Create a new resume using an interactive interface",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return ui.StartCreateResume()
+		return ui.StartCreateResume(timeline.Options{GapMonths: appConfig.Timeline.GapMonths})
 	},
 }
 
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/loveRyujin/ResuGo/internal/diff"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffFormat  string
+	diffNoColor bool
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [old] [new]",
+	Short: "Compare two versions of a resume",
+	Long: `Compare two resume files entry by entry instead of line by line. Experience is
+matched by company and position, projects by name, education by institution
+and degree, so moving an entry around is not reported as a change.
+
+Either side may be a snapshot written as @<snapshot-id>, e.g.
+"resumgo diff @latest resume.yaml".
+
+Output is colored text by default (colors are disabled when stdout is not a
+terminal or NO_COLOR is set); use --format markdown for review notes or
+--format json for scripts.`,
+	Args: cobra.ExactArgs(2),
+	RunE: diffResumes,
+}
+
+func diffResumes(cmd *cobra.Command, args []string) error {
+	oldResume, err := loadResumeOrSnapshot(args[0])
+	if err != nil {
+		return err
+	}
+	newResume, err := loadResumeOrSnapshot(args[1])
+	if err != nil {
+		return err
+	}
+
+	changes := diff.Compare(oldResume, newResume)
+	logger.Debug("diff computed", "old", args[0], "new", args[1], "changes", len(changes))
+
+	switch diffFormat {
+	case "text":
+		color := !diffNoColor && os.Getenv("NO_COLOR") == "" && isatty.IsTerminal(os.Stdout.Fd())
+		diff.WriteText(os.Stdout, changes, color)
+	case "markdown":
+		diff.WriteMarkdown(os.Stdout, changes)
+	case "json":
+		if changes == nil {
+			changes = []diff.Change{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(changes)
+	default:
+		return fmt.Errorf("unsupported diff format: %s (valid: text, markdown, json)", diffFormat)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringVar(&diffFormat, "format", "text", "Output format (text, markdown, json)")
+	diffCmd.Flags().BoolVar(&diffNoColor, "no-color", false, "Disable colored output")
+}
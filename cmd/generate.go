@@ -1,78 +1,288 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
 
+	"github.com/loveRyujin/ResuGo/internal/config"
 	"github.com/loveRyujin/ResuGo/internal/generator"
 	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/redact"
+	"github.com/loveRyujin/ResuGo/internal/resumefile"
+	"github.com/loveRyujin/ResuGo/internal/watch"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v3"
 )
 
 var (
 	outputFormat string
 	outputPath   string
+	outputTheme  string
+	maxPages     int
+	watchInput   bool
+	redactLevel  string
 )
 
 var generateCmd = &cobra.Command{
 	Use:   "generate [input-file]",
 	Short: "Generate resume from YAML file",
-	Long:  "Generate resume in different formats (markdown, pdf) from a YAML input file",
-	Args:  cobra.ExactArgs(1),
-	RunE:  generateResume,
+	Long: `Generate resume in different formats (yaml, markdown, html, pdf) from a YAML input file.
+
+With --max-pages the PDF is measured after layout and, if it overflows, spacing,
+font size and entries marked "priority: low" are reduced in the order set by
+fit.strategies in the config until it fits.
+
+With --redact the output is anonymized for blind review. Give the level with an
+equals sign, e.g. --redact=contact, as a separate word is read as another
+input file. Levels build on each other: "contact" masks name, email, phone,
+location and links and scrubs email addresses and URLs from bullets;
+"employers" also replaces company and institution names with descriptors such
+as "Company A"; "full" (the default when no level is given) also removes
+education dates, which reveal age.
+
+With --watch the input file, config files and custom theme CSS are monitored and the output is
+regenerated on every save until interrupted with Ctrl+C.`,
+	Args: cobra.ExactArgs(1),
+	RunE: generateResume,
 }
 
 func generateResume(cmd *cobra.Command, args []string) error {
 	inputFile := args[0]
 
-	// Check if input file exists
-	if _, err := os.Stat(inputFile); os.IsNotExist(err) {
-		return fmt.Errorf("input file %s does not exist", inputFile)
+	format := appConfig.Format
+	if cmd.Flags().Changed("format") {
+		format = outputFormat
+	}
+
+	if maxPages > 0 && format != "pdf" {
+		return fmt.Errorf("--max-pages only applies to paginated formats (pdf), not %s", format)
 	}
 
-	// Read YAML file
-	data, err := os.ReadFile(inputFile)
+	if !watchInput {
+		path, err := runGenerate(inputFile, format)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Resume generated successfully: %s\n", path)
+		return nil
+	}
+
+	return watchGenerate(cmd, inputFile, format)
+}
+
+// runGenerate loads the input file and writes it in the given format,
+// returning the output path
+func runGenerate(inputFile, format string) (string, error) {
+	resume, err := loadResume(inputFile)
 	if err != nil {
-		return fmt.Errorf("failed to read input file: %w", err)
+		return "", err
 	}
+	return writeResume(resume, format, outputPath)
+}
 
-	// Parse YAML
-	var resume models.Resume
-	if err := yaml.Unmarshal(data, &resume); err != nil {
-		return fmt.Errorf("failed to parse YAML: %w", err)
+// writeResume renders the resume in the given format to path, or to the
+// default file in the output directory when path is empty, and returns the
+// path written
+func writeResume(resume *models.Resume, format, path string) (string, error) {
+	appConfig.ApplyAuthor(&resume.PersonalInfo)
+	if redactLevel != "" {
+		level, err := redact.ParseLevel(redactLevel)
+		if err != nil {
+			return "", err
+		}
+		resume = redact.Resume(resume, level, appConfig.Locale)
+		logger.Debug("redacted resume", "level", level)
 	}
 
 	// Create generator
-	gen := generator.NewGenerator(&resume)
+	gen := generator.NewGenerator(resume,
+		generator.WithLocale(appConfig.Locale),
+		generator.WithPageSize(appConfig.PageSize),
+		generator.WithDefaultLayout(appConfig.Layout),
+		generator.WithLogger(logger),
+	)
 
 	// Generate output
-	switch outputFormat {
+	switch format {
 	case "yaml":
-		if outputPath == "" {
-			outputPath = "resume.yaml"
-		}
-		if err := gen.GenerateYAML(outputPath); err != nil {
-			return fmt.Errorf("failed to generate YAML: %w", err)
+		path = resolveOutputPath(path, "resume.yaml")
+		if err := gen.GenerateYAML(path); err != nil {
+			return "", fmt.Errorf("failed to generate YAML: %w", err)
 		}
 	case "markdown", "md":
-		if outputPath == "" {
-			outputPath = "resume.md"
+		path = resolveOutputPath(path, "resume.md")
+		if err := gen.GenerateMarkdown(path); err != nil {
+			return "", fmt.Errorf("failed to generate Markdown: %w", err)
 		}
-		if err := gen.GenerateMarkdown(outputPath); err != nil {
-			return fmt.Errorf("failed to generate Markdown: %w", err)
+	case "html":
+		path = resolveOutputPath(path, "resume.html")
+		if err := gen.GenerateHTML(path, theme()); err != nil {
+			return "", fmt.Errorf("failed to generate HTML: %w", err)
 		}
+	case "pdf":
+		path = resolveOutputPath(path, "resume.pdf")
+		report, err := gen.GeneratePDF(path, generator.PDFOptions{
+			Theme:       theme(),
+			MaxPages:    maxPages,
+			MinFontSize: appConfig.Fit.MinFontSize,
+			Strategies:  appConfig.Fit.Strategies,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to generate PDF: %w", err)
+		}
+		for _, adjustment := range report.Adjustments {
+			fmt.Printf("  fit: %s\n", adjustment)
+		}
+		logger.Info("PDF laid out", "pages", report.Pages)
 	default:
-		return fmt.Errorf("unsupported output format: %s", outputFormat)
+		return "", fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return path, nil
+}
+
+// watchGenerate regenerates the output whenever the input or config changes.
+// Errors are reported and watching continues, so a half-edited file does not
+// end the session.
+func watchGenerate(cmd *cobra.Command, inputFile, format string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	configPath, _ := cmd.Flags().GetString("config")
+	regenerate := func() {
+		path, err := runGenerate(inputFile, format)
+		stamp := time.Now().Format("15:04:05")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[%s] ✗ %v\n", stamp, err)
+			return
+		}
+		fmt.Printf("[%s] ✓ Resume generated: %s\n", stamp, path)
+	}
+
+	regenerate()
+	fmt.Printf("Watching %s for changes (Ctrl+C to stop)...\n", inputFile)
+
+	w := watch.New(func() []string { return watchedFiles(inputFile, theme()) })
+	err := w.Run(ctx, func(changed []string) {
+		logger.Debug("files changed", "paths", changed)
+		reloadChangedConfig(cmd, configPath, changed)
+		regenerate()
+	})
+	if errors.Is(err, context.Canceled) {
+		fmt.Println("\nStopped watching.")
+		return nil
+	}
+	return err
+}
+
+// watchedFiles lists the files whose changes affect the output in watch mode:
+// the resume and its includes, the config files and a custom theme stylesheet
+func watchedFiles(inputFile, theme string) []string {
+	paths := append(resumeSources(inputFile), configSources...)
+	if file := generator.ThemeFile(theme); file != "" {
+		paths = append(paths, file)
 	}
+	return paths
+}
+
+// reloadChangedConfig reloads the config when one of its files is among changed
+func reloadChangedConfig(cmd *cobra.Command, configPath string, changed []string) {
+	for _, path := range changed {
+		if slices.Contains(configSources, path) {
+			reloadConfig(cmd, configPath)
+			return
+		}
+	}
+}
 
-	fmt.Printf("Resume generated successfully: %s\n", outputPath)
-	return nil
+// reloadConfig re-reads config files after they change in watch mode,
+// keeping the previous config if the new one is invalid
+func reloadConfig(cmd *cobra.Command, configPath string) {
+	cfg, sources, err := config.Load(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "✗ config not reloaded: %v\n", err)
+		return
+	}
+	if cmd.Flags().Changed("verbose") {
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		cfg.Verbose = &verbose
+	}
+	appConfig = cfg
+	configSources = sources
+	logger.Debug("reloaded config", "sources", sources)
+}
+
+// loadResume reads and parses a resume YAML file together with the files it
+// includes
+func loadResume(inputFile string) (*models.Resume, error) {
+	// Check if input file exists
+	if _, err := os.Stat(inputFile); os.IsNotExist(err) {
+		return nil, fmt.Errorf("input file %s does not exist", inputFile)
+	}
+
+	logger.Debug("reading input", "path", inputFile)
+	resume, files, err := resumefile.Load(inputFile)
+	if err == nil {
+		// The photo path is relative to the resume, and editing it should
+		// trigger a rebuild in watch mode like the resume itself
+		resume.BaseDir = filepath.Dir(inputFile)
+		if photo := resume.PhotoPath(); photo != "" {
+			files = append(files, photo)
+		}
+	}
+	if files != nil {
+		sourceFiles.Store(inputFile, files)
+	}
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug("resolved includes", "path", inputFile, "files", files)
+	return resume, nil
+}
+
+// sourceFiles remembers the files each input was assembled from the last time it
+// was read, so watch mode also notices changes to included files
+var sourceFiles sync.Map
+
+// resumeSources returns the files inputFile was assembled from
+func resumeSources(inputFile string) []string {
+	if files, ok := sourceFiles.Load(inputFile); ok {
+		return files.([]string)
+	}
+	return []string{inputFile}
+}
+
+// theme returns the --theme flag if set, otherwise the configured theme
+func theme() string {
+	if outputTheme != "" {
+		return outputTheme
+	}
+	return appConfig.Theme
+}
+
+// resolveOutputPath returns the explicit path if given, otherwise the default
+// file name inside the configured output directory
+func resolveOutputPath(path, defaultName string) string {
+	if path != "" {
+		return path
+	}
+	return filepath.Join(appConfig.OutputDir, defaultName)
 }
 
 func init() {
 	rootCmd.AddCommand(generateCmd)
 
-	generateCmd.Flags().StringVarP(&outputFormat, "format", "f", "markdown", "Output format (yaml, markdown)")
+	generateCmd.Flags().StringVarP(&outputFormat, "format", "f", "markdown", "Output format (yaml, markdown, html, pdf)")
 	generateCmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path")
+	generateCmd.Flags().StringVarP(&outputTheme, "theme", "t", "", "Theme for HTML and PDF output; HTML also accepts a path to a .css file (default from config)")
+	generateCmd.Flags().IntVar(&maxPages, "max-pages", 0, "Fit PDF output to at most this many pages, or fail")
+	generateCmd.Flags().BoolVarP(&watchInput, "watch", "w", false, "Regenerate whenever the input file changes")
+	generateCmd.Flags().StringVar(&redactLevel, "redact", "", "Anonymize for blind review: --redact=contact, --redact=employers or --redact (full)")
+	generateCmd.Flags().Lookup("redact").NoOptDefVal = string(redact.LevelFull)
 }
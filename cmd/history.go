@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/history"
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/resumefile"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	snapshotMessage string
+	historyJSON     bool
+	restoreOutput   string
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot [input-file]",
+	Short: "Store a timestamped copy of a resume",
+	Long: `Store a copy of the resume in the local snapshot store (data_dir in the config,
+".resugo" by default). Resumes split across files are stored as one assembled
+file, so a snapshot records exactly what was sent.`,
+	Args: cobra.ExactArgs(1),
+	RunE: takeSnapshot,
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List resume snapshots",
+	Args:  cobra.NoArgs,
+	RunE:  listSnapshots,
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [snapshot-id]",
+	Short: "Bring back a resume snapshot",
+	Long: `Write a snapshot back to the file it was taken from, or to --output. The ID may
+be shortened to any unique prefix, or given as "latest". If the target file
+has changes that are not in any snapshot, they are snapshotted first. A resume
+split across files with !include is not overwritten; restore it to a new
+file with --output.`,
+	Args: cobra.ExactArgs(1),
+	RunE: restoreSnapshot,
+}
+
+func takeSnapshot(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+	data, err := resumeContent(inputFile)
+	if err != nil {
+		return err
+	}
+
+	snap, err := history.Open(appConfig.DataDir).Save(inputFile, data, snapshotMessage, time.Now())
+	if err != nil {
+		return err
+	}
+	logger.Debug("snapshot saved", "id", snap.ID, "store", appConfig.DataDir)
+	fmt.Printf("Snapshot %s saved\n", snap.ID)
+	return nil
+}
+
+func listSnapshots(cmd *cobra.Command, args []string) error {
+	snapshots, err := history.Open(appConfig.DataDir).List()
+	if err != nil {
+		return err
+	}
+
+	if historyJSON {
+		if snapshots == nil {
+			snapshots = []history.Snapshot{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(snapshots)
+	}
+
+	if len(snapshots) == 0 {
+		fmt.Println("No snapshots yet. Create one with: resumgo snapshot resume.yaml -m \"message\"")
+		return nil
+	}
+	fmt.Printf("%-26s %-16s %-20s %s\n", "ID", "CREATED", "SOURCE", "MESSAGE")
+	for _, snap := range snapshots {
+		fmt.Printf("%-26s %-16s %-20s %s\n", snap.ID, snap.Created.Local().Format("2006-01-02 15:04"),
+			snap.Source, snap.Message)
+	}
+	return nil
+}
+
+func restoreSnapshot(cmd *cobra.Command, args []string) error {
+	store := history.Open(appConfig.DataDir)
+	snap, err := store.Find(args[0])
+	if err != nil {
+		return err
+	}
+	data, err := store.Read(snap)
+	if err != nil {
+		return err
+	}
+
+	target := restoreOutput
+	if target == "" {
+		target = snap.Source
+	}
+
+	// Snapshots of resumes split with !include hold the assembled document,
+	// which must not replace a main file that includes the others
+	if doc, err := resumefile.Read(target); err == nil && len(doc.Files) > 1 {
+		return fmt.Errorf("%s includes other files; restore the assembled snapshot to a new file with --output", target)
+	}
+
+	// Keep unsaved work: snapshot the current file unless it is already stored
+	current, err := os.ReadFile(target)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read %s: %w", target, err)
+	}
+	if err == nil && !bytes.Equal(current, data) {
+		stored, err := isStored(store, current)
+		if err != nil {
+			return err
+		}
+		if !stored {
+			backup, err := store.Save(target, current, "before restoring "+snap.ID, time.Now())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Saved current %s as snapshot %s\n", target, backup.ID)
+		}
+	}
+
+	if err := os.WriteFile(target, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	fmt.Printf("Restored snapshot %s to %s\n", snap.ID, target)
+	return nil
+}
+
+// isStored reports whether some snapshot already holds exactly data
+func isStored(store *history.Store, data []byte) (bool, error) {
+	snapshots, err := store.List()
+	if err != nil {
+		return false, err
+	}
+	checksum := history.Checksum(data)
+	for _, snap := range snapshots {
+		if snap.SHA256 == checksum {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resumeContent returns the bytes to snapshot: the file itself, or the
+// assembled document when it includes other files
+func resumeContent(inputFile string) ([]byte, error) {
+	if _, err := os.Stat(inputFile); os.IsNotExist(err) {
+		return nil, fmt.Errorf("input file %s does not exist", inputFile)
+	}
+	doc, err := resumefile.Read(inputFile)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := doc.Decode(); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if len(doc.Files) == 1 {
+		return os.ReadFile(inputFile)
+	}
+	return doc.Bytes()
+}
+
+// loadSnapshot parses the resume stored in a snapshot
+func loadSnapshot(ref string) (*models.Resume, error) {
+	store := history.Open(appConfig.DataDir)
+	snap, err := store.Find(ref)
+	if err != nil {
+		return nil, err
+	}
+	data, err := store.Read(snap)
+	if err != nil {
+		return nil, err
+	}
+	var resume models.Resume
+	if err := yaml.Unmarshal(data, &resume); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", snap.ID, err)
+	}
+	return &resume, nil
+}
+
+// loadResumeOrSnapshot loads "@<snapshot-id>" from the snapshot store and
+// anything else as a resume file
+func loadResumeOrSnapshot(arg string) (*models.Resume, error) {
+	if ref, ok := strings.CutPrefix(arg, "@"); ok {
+		return loadSnapshot(ref)
+	}
+	return loadResume(arg)
+}
+
+func init() {
+	rootCmd.AddCommand(snapshotCmd)
+	rootCmd.AddCommand(historyCmd)
+	rootCmd.AddCommand(restoreCmd)
+
+	snapshotCmd.Flags().StringVarP(&snapshotMessage, "message", "m", "", "Note stored with the snapshot, e.g. where it was sent")
+	historyCmd.Flags().BoolVar(&historyJSON, "json", false, "Print snapshots as JSON")
+	restoreCmd.Flags().StringVarP(&restoreOutput, "output", "o", "", "Write to this file instead of the snapshot's source")
+}
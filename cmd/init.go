@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/config"
+	"github.com/loveRyujin/ResuGo/templates"
+	"github.com/spf13/cobra"
+)
+
+var (
+	initProfile    string
+	initWithConfig bool
+	initWithLetter bool
+	initForce      bool
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init [dir]",
+	Short: "Create a starter resume.yaml",
+	Long: `Create a commented starter resume.yaml in the given directory (default: current directory).
+
+Use --profile to pick a starting point, --with-config to also write a
+.resugo.yaml project config and --with-letter to add a cover letter template. Existing files are never overwritten unless --force is set.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: initProject,
+}
+
+func initProject(cmd *cobra.Command, args []string) error {
+	dir := "."
+	if len(args) == 1 {
+		dir = args[0]
+	}
+
+	resume, err := templates.Profile(initProfile)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	type starterFile struct {
+		path string
+		data []byte
+	}
+	files := []starterFile{{filepath.Join(dir, "resume.yaml"), resume}}
+	if initWithConfig {
+		files = append(files, starterFile{filepath.Join(dir, config.ProjectFileName), templates.Config()})
+	}
+	if initWithLetter {
+		files = append(files, starterFile{filepath.Join(dir, "cover-letter.yaml"), templates.CoverLetter()})
+	}
+
+	// Check every target first so a refusal leaves nothing half-written
+	if !initForce {
+		for _, f := range files {
+			if _, err := os.Stat(f.path); err == nil {
+				return fmt.Errorf("%s already exists (use --force to overwrite)", f.path)
+			}
+		}
+	}
+
+	for _, f := range files {
+		if err := os.WriteFile(f.path, f.data, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.path, err)
+		}
+		logger.Debug("wrote starter file", "path", f.path, "profile", initProfile)
+		fmt.Printf("Created %s\n", f.path)
+	}
+
+	fmt.Printf("\nEdit resume.yaml, then run:\n  resumgo generate %s\n", filepath.Join(dir, "resume.yaml"))
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+
+	initCmd.Flags().StringVarP(&initProfile, "profile", "p", templates.DefaultProfile,
+		fmt.Sprintf("Starter profile (%s)", strings.Join(templates.Profiles(), ", ")))
+	initCmd.Flags().BoolVar(&initWithConfig, "with-config", false, "Also write a .resugo.yaml project config")
+	initCmd.Flags().BoolVar(&initWithLetter, "with-letter", false, "Also write a cover-letter.yaml template")
+	initCmd.Flags().BoolVar(&initForce, "force", false, "Overwrite existing files")
+}
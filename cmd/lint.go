@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/lint"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintDisabled  []string
+	lintFailOn    string
+	lintJSON      bool
+	lintListRules bool
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [input-file]",
+	Short: "Check resume content for common writing problems",
+	Long: `Check bullet points and summary for weak openers, missing numbers, first-person
+pronouns, overly long bullets, inconsistent tense and duplicates. English and
+Chinese text are both supported.
+
+Rules are configured under "lint.rules" in .resugo.yaml, for example:
+
+  lint:
+    rules:
+      long-bullet: {max_words: 25, max_chars: 50}
+      first-person: {enabled: false}
+      missing-number: {severity: warning}
+
+The command exits with an error when any issue is at least --fail-on severe.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if lintListRules {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	RunE: lintResume,
+}
+
+func lintResume(cmd *cobra.Command, args []string) error {
+	if lintListRules {
+		for _, rule := range lint.Rules() {
+			fmt.Printf("%-18s %-8s %s\n", rule.Name(), rule.DefaultSeverity(), rule.Description())
+		}
+		return nil
+	}
+
+	failOn := lint.Severity(lintFailOn)
+	switch failOn {
+	case lint.SeverityInfo, lint.SeverityWarning, lint.SeverityError:
+	default:
+		return fmt.Errorf("invalid --fail-on %q (valid: info, warning, error)", lintFailOn)
+	}
+
+	inputFile := args[0]
+	resume, err := loadResume(inputFile)
+	if err != nil {
+		return err
+	}
+
+	issues := lint.Run(resume, appConfig.Lint, lintDisabled...)
+	logger.Debug("lint finished", "path", inputFile, "issues", len(issues))
+
+	if lintJSON {
+		if issues == nil {
+			issues = []lint.Issue{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(issues); err != nil {
+			return err
+		}
+	} else {
+		printLintIssues(inputFile, issues)
+	}
+
+	failed := 0
+	for _, issue := range issues {
+		if issue.Severity.AtLeast(failOn) {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d issue(s) at or above %s severity", failed, failOn)
+	}
+	return nil
+}
+
+func printLintIssues(inputFile string, issues []lint.Issue) {
+	if len(issues) == 0 {
+		fmt.Printf("✓ %s: no issues found\n", inputFile)
+		return
+	}
+
+	counts := make(map[lint.Severity]int)
+	for _, issue := range issues {
+		counts[issue.Severity]++
+	}
+	var summary []string
+	for _, severity := range []lint.Severity{lint.SeverityError, lint.SeverityWarning, lint.SeverityInfo} {
+		if counts[severity] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[severity], severity))
+		}
+	}
+	fmt.Printf("%s: %d issue(s) (%s)\n\n", inputFile, len(issues), strings.Join(summary, ", "))
+
+	for _, issue := range issues {
+		fmt.Printf("%-8s %-18s %s\n", issue.Severity, issue.Rule, issue.Location)
+		fmt.Printf("%27s %s\n", "", issue.Message)
+		if issue.Text != "" {
+			fmt.Printf("%27s > %s\n", "", issue.Text)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	lintCmd.Flags().StringSliceVar(&lintDisabled, "disable", nil, "Rules to skip (comma-separated)")
+	lintCmd.Flags().StringVar(&lintFailOn, "fail-on", "warning", "Lowest severity that makes the command fail (info, warning, error)")
+	lintCmd.Flags().BoolVar(&lintJSON, "json", false, "Print issues as JSON")
+	lintCmd.Flags().BoolVar(&lintListRules, "list-rules", false, "List available rules and exit")
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/match"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+var (
+	matchJSON        bool
+	matchMaxKeywords int
+	matchMinCount    int
+)
+
+var matchCmd = &cobra.Command{
+	Use:   "match [resume-file] [job-file]",
+	Short: "Compare a resume against a job posting",
+	Long: `Extract keywords and skills from a locally saved job posting (plain text) and
+report which of them appear in the resume's skills, experience and projects,
+which are missing, and a weighted coverage score. Works offline and handles
+both English and Chinese postings.`,
+	Args: cobra.ExactArgs(2),
+	RunE: matchResume,
+}
+
+func matchResume(cmd *cobra.Command, args []string) error {
+	resume, err := loadResume(args[0])
+	if err != nil {
+		return err
+	}
+	job, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to read job posting: %w", err)
+	}
+
+	report := match.Analyze(resume, string(job), match.Options{
+		MaxKeywords: matchMaxKeywords,
+		MinCount:    matchMinCount,
+	})
+	logger.Debug("match finished", "keywords", len(report.Keywords), "coverage", report.Coverage)
+
+	if matchJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	printMatchReport(report)
+	return nil
+}
+
+func printMatchReport(report match.Report) {
+	if len(report.Keywords) == 0 {
+		fmt.Println("No keywords found in the job posting.")
+		return
+	}
+
+	fmt.Printf("Coverage: %.0f%% (%d of %d keywords)\n\n",
+		report.Coverage*100, len(report.Matched()), len(report.Keywords))
+
+	mark := func(ok bool) string {
+		if ok {
+			return "✓"
+		}
+		return "·"
+	}
+
+	fmt.Printf("%-28s %5s  %-6s %-10s %-8s\n", "KEYWORD", "COUNT", "SKILLS", "EXPERIENCE", "PROJECTS")
+	for _, k := range report.Keywords {
+		term := k.Term
+		if k.Skill {
+			term += " *"
+		}
+		fmt.Printf("%s %5d  %-6s %-10s %-8s\n", padRight(term, 28), k.Count,
+			mark(k.InSkills), mark(k.InExperience), mark(k.InProjects))
+	}
+	fmt.Println("\n* recognized skill (weighted higher in coverage)")
+
+	if missing := report.Missing(); len(missing) > 0 {
+		terms := make([]string, len(missing))
+		for i, k := range missing {
+			terms[i] = k.Term
+		}
+		fmt.Printf("\nMissing: %s\n", strings.Join(terms, ", "))
+	}
+}
+
+// padRight pads s with spaces to the given display width, counting wide
+// characters such as Chinese as two columns
+func padRight(s string, width int) string {
+	return runewidth.FillRight(s, width)
+}
+
+func init() {
+	rootCmd.AddCommand(matchCmd)
+
+	matchCmd.Flags().BoolVar(&matchJSON, "json", false, "Print the report as JSON")
+	matchCmd.Flags().IntVar(&matchMaxKeywords, "max-keywords", 20, "Maximum number of non-skill keywords to report")
+	matchCmd.Flags().IntVar(&matchMinCount, "min-count", 2, "Minimum occurrences for a non-skill keyword")
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/resumefile"
+	"github.com/spf13/cobra"
+)
+
+var (
+	mergeOutput   string
+	mergeStrategy string
+)
+
+var mergeCmd = &cobra.Command{
+	Use:   "merge [file]...",
+	Short: "Deep-merge several resume files into one",
+	Long: `Merge resume files from left to right into a single YAML resume. Mappings are
+merged key by key and later values win. Lists follow --lists:
+
+  key      Experience, education, projects, languages, custom sections and
+           custom skill categories are matched by their identifying fields
+           (e.g. company + position) and merged; other entries are appended.
+           Lists of plain values such as skills keep one copy of each item.
+  append   Lists are concatenated.
+  replace  A later list replaces the earlier one.
+
+Includes in each file are resolved before merging. The result is written to
+stdout unless --output is given.`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: mergeResumes,
+}
+
+func mergeResumes(cmd *cobra.Command, args []string) error {
+	strategy := resumefile.Strategy(mergeStrategy)
+
+	var merged *resumefile.Document
+	for _, path := range args {
+		doc, err := resumefile.Read(path)
+		if err != nil {
+			return err
+		}
+		if merged == nil {
+			merged = doc
+			continue
+		}
+		if err := resumefile.Merge(merged.Root, doc.Root, strategy); err != nil {
+			return err
+		}
+		merged.Files = append(merged.Files, doc.Files...)
+	}
+	logger.Debug("merged resumes", "files", merged.Files, "strategy", strategy)
+
+	// Make sure the result is still a valid resume before writing it
+	if _, err := merged.Decode(); err != nil {
+		return fmt.Errorf("merged resume is invalid: %w", err)
+	}
+	data, err := merged.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if mergeOutput == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(mergeOutput, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	fmt.Printf("Merged %s into %s\n", strings.Join(args, ", "), mergeOutput)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(mergeCmd)
+
+	mergeCmd.Flags().StringVarP(&mergeOutput, "output", "o", "", "Output file path (default: stdout)")
+	mergeCmd.Flags().StringVar(&mergeStrategy, "lists", string(resumefile.MatchByKey), "How lists are combined (key, append, replace)")
+}
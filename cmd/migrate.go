@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/history"
+	"github.com/loveRyujin/ResuGo/internal/migrate"
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	migrateOutput string
+	migrateWrite  bool
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate [input-file]",
+	Short: "Move custom sections into typed certification, award, publication and volunteer sections",
+	Long: `Promote custom sections under "additional" whose title names a typed section,
+such as "Certifications", "Honors & Awards", "Publications" or "志愿经历", into
+certifications, awards, publications or volunteer. Items written as
+"name - issuer (2021)" are split into their fields. A date given only as a
+year becomes January of that year; review the result and fill in anything
+the plain text did not say.
+
+Only the input file itself is changed; included files are not followed.
+The result is written to stdout unless --output or --write is given. --write
+replaces the input file after saving it as a snapshot.`,
+	Args: cobra.ExactArgs(1),
+	RunE: migrateResume,
+}
+
+func migrateResume(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+	original, err := os.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(original, &doc); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	promotions, err := migrate.Additional(&doc)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	// Make sure the result is still a valid resume before writing it
+	var resume models.Resume
+	if err := yaml.Unmarshal(buf.Bytes(), &resume); err != nil {
+		return fmt.Errorf("migrated resume is invalid: %w", err)
+	}
+
+	// Keep stdout clean for the YAML when no file is written
+	report := io.Writer(os.Stdout)
+	if migrateOutput == "" && !migrateWrite {
+		report = os.Stderr
+	}
+	if len(promotions) == 0 {
+		fmt.Fprintln(report, "No custom sections to migrate.")
+	}
+	for _, p := range promotions {
+		if p.Items > 0 {
+			fmt.Fprintf(report, "Moved %q (%d items) to %s\n", p.Title, p.Items, p.Section)
+		}
+		if len(p.Kept) > 0 {
+			fmt.Fprintf(report, "  Kept %d item(s) in %q that lack a required field; move them by hand:\n", len(p.Kept), p.Title)
+			for _, item := range p.Kept {
+				fmt.Fprintf(report, "    %s\n", item)
+			}
+		}
+	}
+
+	switch {
+	case migrateWrite:
+		if len(promotions) == 0 {
+			return nil
+		}
+		snap, err := history.Open(appConfig.DataDir).Save(inputFile, original, "before migrate", time.Now())
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(inputFile, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Updated %s (previous version saved as snapshot %s)\n", inputFile, snap.ID)
+	case migrateOutput != "":
+		if err := os.WriteFile(migrateOutput, buf.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Migrated resume written to %s\n", migrateOutput)
+	default:
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+
+	migrateCmd.Flags().StringVarP(&migrateOutput, "output", "o", "", "Output file path (default: stdout)")
+	migrateCmd.Flags().BoolVarP(&migrateWrite, "write", "w", false, "Replace the input file, keeping a snapshot of the original")
+	migrateCmd.MarkFlagsMutuallyExclusive("output", "write")
+}
@@ -1,22 +1,62 @@
 package cmd
 
 import (
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/loveRyujin/ResuGo/internal/config"
 	"github.com/spf13/cobra"
 )
 
+var (
+	// appConfig is the effective configuration, loaded before any command runs
+	appConfig = config.Default()
+	// configSources lists the config files appConfig was loaded from
+	configSources []string
+	// logger emits progress logs when --verbose is set and discards them otherwise
+	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+)
+
 var rootCmd = &cobra.Command{
 	Use:   "resumgo",
 	Short: "A command-line resume generation tool",
 	Long: `ResuGo is an interactive command-line tool for creating and managing personal resumes.
 It uses elegant terminal user interface to help you build professional resumes.`,
+	PersistentPreRunE: loadConfig,
+	SilenceUsage:      true,
 }
 
 func Execute() error {
 	return rootCmd.Execute()
 }
 
+// loadConfig resolves the configuration and sets up the logger
+func loadConfig(cmd *cobra.Command, args []string) error {
+	configPath, _ := cmd.Flags().GetString("config")
+
+	cfg, sources, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("verbose") {
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		cfg.Verbose = &verbose
+	}
+	if cfg.IsVerbose() {
+		logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+
+	appConfig = cfg
+	configSources = sources
+	logger.Debug("loaded config", "sources", sources, "format", cfg.Format,
+		"output_dir", cfg.OutputDir, "theme", cfg.Theme, "locale", cfg.Locale, "page_size", cfg.PageSize)
+	return nil
+}
+
 func init() {
 	// Add global flags here
-	rootCmd.PersistentFlags().StringP("config", "c", "", "config file path")
+	rootCmd.PersistentFlags().StringP("config", "c", "", "config file path (default: .resugo.yaml, then ~/.config/resugo/config.yaml)")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "show verbose output")
 }
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/generator"
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/preview"
+	"github.com/loveRyujin/ResuGo/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+var serveAddr string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve [input-file]",
+	Short: "Preview the resume in a browser with live reload",
+	Long: `Start a local HTTP server that renders the resume as HTML in every available
+theme. Open pages reload automatically when the input file, a file it includes
+or the config changes, and each output format can be downloaded from the page.`,
+	Args: cobra.ExactArgs(1),
+	RunE: serveResume,
+}
+
+func serveResume(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+	if _, err := os.Stat(inputFile); err != nil {
+		return fmt.Errorf("input file %s does not exist", inputFile)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Requests read the config while the watcher may reload it
+	var configMu sync.RWMutex
+	load := func() (*models.Resume, error) {
+		resume, err := loadResume(inputFile)
+		if err != nil {
+			return nil, err
+		}
+		configMu.RLock()
+		defer configMu.RUnlock()
+		appConfig.ApplyAuthor(&resume.PersonalInfo)
+		return resume, nil
+	}
+	newGenerator := func(resume *models.Resume) *generator.Generator {
+		configMu.RLock()
+		defer configMu.RUnlock()
+		return generator.NewGenerator(resume,
+			generator.WithLocale(appConfig.Locale),
+			generator.WithPageSize(appConfig.PageSize),
+			generator.WithDefaultLayout(appConfig.Layout),
+			generator.WithLogger(logger),
+		)
+	}
+	server := preview.NewServer(load, newGenerator, logger)
+
+	listener, err := net.Listen("tcp", serveAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", serveAddr, err)
+	}
+	httpServer := &http.Server{Handler: server.Handler()}
+
+	configPath, _ := cmd.Flags().GetString("config")
+	go func() {
+		w := watch.New(func() []string { return watchedFiles(inputFile, appConfig.Theme) })
+		w.Run(ctx, func(changed []string) {
+			logger.Debug("files changed", "paths", changed)
+			configMu.Lock()
+			reloadChangedConfig(cmd, configPath, changed)
+			configMu.Unlock()
+			if _, err := load(); err != nil {
+				fmt.Fprintf(os.Stderr, "[%s] ✗ %v\n", time.Now().Format("15:04:05"), err)
+			} else {
+				fmt.Printf("[%s] ✓ %s changed, reloading\n", time.Now().Format("15:04:05"), strings.Join(changed, ", "))
+			}
+			server.Reload()
+		})
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		httpServer.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("Serving %s at http://%s (Ctrl+C to stop)\n", inputFile, listener.Addr())
+	if err := httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	fmt.Println("\nServer stopped.")
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().StringVarP(&serveAddr, "addr", "a", "127.0.0.1:8080", "Address to listen on")
+}
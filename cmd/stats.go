@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/loveRyujin/ResuGo/internal/stats"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+var statsJSON bool
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [input-file]",
+	Short: "Show resume statistics",
+	Long: `Report total years of experience (overlapping roles are counted once), years
+per technology inferred from the "technologies" tags of experience and project
+entries, bullet counts per role, word counts per section and the average
+bullet length. Each Chinese character counts as one word.`,
+	Args: cobra.ExactArgs(1),
+	RunE: showStats,
+}
+
+func showStats(cmd *cobra.Command, args []string) error {
+	resume, err := loadResume(args[0])
+	if err != nil {
+		return err
+	}
+
+	report := stats.Compute(resume, stats.Options{})
+	logger.Debug("stats computed", "path", args[0], "roles", len(report.Roles), "technologies", len(report.Technologies))
+
+	if statsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	printStatsReport(report)
+	return nil
+}
+
+func printStatsReport(report stats.Report) {
+	fmt.Printf("Experience: %.1f years (%d months)\n", report.ExperienceYears, report.ExperienceMonths)
+	fmt.Printf("Bullets:    %d (average %.1f words)\n", report.Bullets, report.AverageBulletWords)
+
+	if len(report.Roles) > 0 {
+		fmt.Printf("\n%s %6s %7s\n", padRight("ROLE", 40), "MONTHS", "BULLETS")
+		for _, role := range report.Roles {
+			name := role.Company
+			if role.Position != "" {
+				name += " / " + role.Position
+			}
+			fmt.Printf("%s %6d %7d\n", padRight(runewidth.Truncate(name, 40, "…"), 40), role.Months, role.Bullets)
+		}
+	}
+
+	if len(report.Technologies) > 0 {
+		fmt.Printf("\n%s %6s %7s\n", padRight("TECHNOLOGY", 24), "YEARS", "ENTRIES")
+		for _, tech := range report.Technologies {
+			fmt.Printf("%s %6.1f %7d\n", padRight(tech.Name, 24), tech.Years, tech.Entries)
+		}
+	}
+
+	if len(report.Sections) > 0 {
+		fmt.Printf("\n%-12s %6s\n", "SECTION", "WORDS")
+		for _, section := range report.Sections {
+			fmt.Printf("%-12s %6d\n", section.Section, section.Words)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Print the report as JSON")
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/loveRyujin/ResuGo/internal/resumefile"
+	"github.com/loveRyujin/ResuGo/internal/timeline"
+	"github.com/loveRyujin/ResuGo/internal/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	validateGapMonths int
+	validateStrict    bool
+	validateJSON      bool
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate [input-file]",
+	Short: "Check a resume file for errors and timeline problems",
+	Long: `Check a resume YAML file for unknown fields, missing required fields, invalid
+layout settings and timeline problems: employment gaps, overlapping full-time
+roles, end dates before start dates, future start dates and more than one
+current job.
+
+Errors make the command fail; warnings only do with --strict.`,
+	Args: cobra.ExactArgs(1),
+	RunE: validateResume,
+}
+
+func validateResume(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+	resume, err := loadResume(inputFile)
+	if err != nil {
+		return err
+	}
+	// Check the assembled document so included files are covered too
+	doc, err := resumefile.Read(inputFile)
+	if err != nil {
+		return err
+	}
+	data, err := doc.Bytes()
+	if err != nil {
+		return err
+	}
+
+	gapMonths := appConfig.Timeline.GapMonths
+	if cmd.Flags().Changed("gap-months") {
+		if validateGapMonths < 0 {
+			return fmt.Errorf("--gap-months must not be negative, got %d", validateGapMonths)
+		}
+		gapMonths = &validateGapMonths
+	}
+
+	problems := validate.StrictParse(data)
+	problems = append(problems, validate.Resume(resume, validate.Options{
+		Timeline: timeline.Options{GapMonths: gapMonths},
+	})...)
+	logger.Debug("validation finished", "path", inputFile, "problems", len(problems))
+
+	if validateJSON {
+		if problems == nil {
+			problems = []validate.Problem{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(problems); err != nil {
+			return err
+		}
+	} else if len(problems) == 0 {
+		fmt.Printf("✓ %s is valid\n", inputFile)
+	} else {
+		for _, p := range problems {
+			icon := "⚠"
+			if p.Severity == validate.SeverityError {
+				icon = "✗"
+			}
+			fmt.Printf("%s %-7s %s: %s\n", icon, p.Severity, p.Field, p.Message)
+		}
+	}
+
+	if validate.HasErrors(problems) {
+		return fmt.Errorf("%s is invalid", inputFile)
+	}
+	if validateStrict && len(problems) > 0 {
+		return fmt.Errorf("%s has %d warning(s)", inputFile, len(problems))
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().IntVar(&validateGapMonths, "gap-months", timeline.DefaultGapMonths, "Report employment gaps longer than this many months, 0 for every gap (default: timeline.gap_months in the config)")
+	validateCmd.Flags().BoolVar(&validateStrict, "strict", false, "Fail on warnings as well as errors")
+	validateCmd.Flags().BoolVar(&validateJSON, "json", false, "Print problems as JSON")
+}
@@ -0,0 +1,173 @@
+package applications
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Status is the stage of an application
+type Status string
+
+const (
+	StatusApplied   Status = "applied"
+	StatusInterview Status = "interview"
+	StatusOffer     Status = "offer"
+	StatusRejected  Status = "rejected"
+)
+
+// Statuses lists the valid statuses in pipeline order
+var Statuses = []Status{StatusApplied, StatusInterview, StatusOffer, StatusRejected}
+
+// ParseStatus validates a status name
+func ParseStatus(s string) (Status, error) {
+	for _, status := range Statuses {
+		if strings.EqualFold(s, string(status)) {
+			return status, nil
+		}
+	}
+	return "", fmt.Errorf("unknown status %q (valid: applied, interview, offer, rejected)", s)
+}
+
+// Application is one job application and what was sent with it
+type Application struct {
+	ID        int       `json:"id"`
+	Company   string    `json:"company"`
+	Role      string    `json:"role"`
+	Variant   string    `json:"variant,omitempty"` // Which resume variant was used, e.g. "backend"
+	Applied   time.Time `json:"applied"`
+	Status    Status    `json:"status"`
+	Source    string    `json:"source"`             // Resume file the artifacts were generated from
+	Snapshot  string    `json:"snapshot,omitempty"` // Snapshot ID of the resume as sent
+	Artifacts []string  `json:"artifacts,omitempty"`
+	Updates   []Update  `json:"updates,omitempty"` // Status changes, oldest first
+}
+
+// Update records a status change
+type Update struct {
+	Date   time.Time `json:"date"`
+	Status Status    `json:"status"`
+	Note   string    `json:"note,omitempty"`
+}
+
+// Store is a JSON file database of applications. Generated artifacts are
+// kept in a directory per application next to it.
+type Store struct {
+	path string
+	dir  string
+}
+
+// Open returns the application store inside a data directory
+func Open(dataDir string) *Store {
+	return &Store{
+		path: filepath.Join(dataDir, "applications.json"),
+		dir:  filepath.Join(dataDir, "applications"),
+	}
+}
+
+// List returns all applications in the order they were added
+func (s *Store) List() ([]Application, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applications: %w", err)
+	}
+	var apps []Application
+	if err := json.Unmarshal(data, &apps); err != nil {
+		return nil, fmt.Errorf("corrupt application database %s: %w", s.path, err)
+	}
+	return apps, nil
+}
+
+// Add assigns the next ID to app, lets artifacts write the application's
+// files into its own directory and records it. Nothing is recorded if
+// artifacts fails.
+func (s *Store) Add(app Application, artifacts func(dir string) ([]string, error)) (Application, error) {
+	apps, err := s.List()
+	if err != nil {
+		return app, err
+	}
+	app.ID = 1
+	for _, existing := range apps {
+		app.ID = max(app.ID, existing.ID+1)
+	}
+	if app.Status == "" {
+		app.Status = StatusApplied
+	}
+	app.Updates = append(app.Updates, Update{Date: app.Applied, Status: app.Status})
+
+	if artifacts != nil {
+		dir := filepath.Join(s.dir, fmt.Sprintf("%03d-%s", app.ID, slug(app.Company)))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return app, fmt.Errorf("failed to create artifact directory: %w", err)
+		}
+		files, err := artifacts(dir)
+		if err != nil {
+			os.RemoveAll(dir)
+			return app, err
+		}
+		app.Artifacts = files
+	}
+
+	return app, s.save(append(apps, app))
+}
+
+// SetStatus changes the status of an application and records the change
+func (s *Store) SetStatus(id int, status Status, note string, now time.Time) (Application, error) {
+	apps, err := s.List()
+	if err != nil {
+		return Application{}, err
+	}
+	for i := range apps {
+		if apps[i].ID != id {
+			continue
+		}
+		apps[i].Status = status
+		apps[i].Updates = append(apps[i].Updates, Update{Date: now, Status: status, Note: note})
+		return apps[i], s.save(apps)
+	}
+	return Application{}, fmt.Errorf("no application with ID %d", id)
+}
+
+// save writes the database atomically so an interrupted write cannot lose it
+func (s *Store) save(apps []Application) error {
+	data, err := json.MarshalIndent(apps, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write applications: %w", err)
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// slug makes a company name safe to use in a directory name
+func slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	result := strings.TrimSuffix(b.String(), "-")
+	if result == "" {
+		return "application"
+	}
+	return result
+}
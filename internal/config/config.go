@@ -0,0 +1,265 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/lint"
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProjectFileName is the project-local config file, searched from the working directory upwards
+	ProjectFileName = ".resugo.yaml"
+	// EnvPrefix prefixes every environment variable read by Load
+	EnvPrefix = "RESUGO_"
+)
+
+// Config holds user defaults shared by all commands
+type Config struct {
+	Format    string        `yaml:"format,omitempty"`     // Default output format
+	OutputDir string        `yaml:"output_dir,omitempty"` // Directory for generated files
+	Theme     string        `yaml:"theme,omitempty"`      // Theme for styled outputs
+	Locale    string        `yaml:"locale,omitempty"`     // en or zh
+	PageSize  string        `yaml:"page_size,omitempty"`  // A4 or Letter
+	Author    Author        `yaml:"author,omitempty"`     // Defaults for empty personal info fields
+	Layout    models.Layout `yaml:"layout,omitempty"`     // Default layout, overridden by the resume's own
+	Fit       Fit           `yaml:"fit,omitempty"`        // How --max-pages shrinks content
+	Lint      lint.Config   `yaml:"lint,omitempty"`       // Content linter rule settings
+	Timeline  Timeline      `yaml:"timeline,omitempty"`   // Timeline check settings
+	DataDir   string        `yaml:"data_dir,omitempty"`   // Local store for snapshots and applications
+	Verbose   *bool         `yaml:"verbose,omitempty"`    // Unset inherits; false turns off an inherited true
+}
+
+// IsVerbose reports whether verbose output is enabled
+func (c Config) IsVerbose() bool {
+	return c.Verbose != nil && *c.Verbose
+}
+
+// Author holds personal details used when a resume leaves them empty
+type Author struct {
+	Name     string `yaml:"name,omitempty"`
+	Email    string `yaml:"email,omitempty"`
+	Phone    string `yaml:"phone,omitempty"`
+	Location string `yaml:"location,omitempty"`
+	Website  string `yaml:"website,omitempty"`
+}
+
+// Fit configures fitting paginated output to a page limit
+type Fit struct {
+	MinFontSize float64  `yaml:"min_font_size,omitempty"` // Smallest body font size in points
+	Strategies  []string `yaml:"strategies,omitempty"`    // Order of: spacing, font, priority
+}
+
+// Timeline configures the timeline checks of validate and create
+type Timeline struct {
+	GapMonths *int `yaml:"gap_months,omitempty"` // Report gaps longer than this; unset means 6, 0 reports every gap
+}
+
+// Default returns the built-in defaults
+func Default() Config {
+	return Config{
+		Format:   "markdown",
+		Theme:    "classic",
+		Locale:   "en",
+		PageSize: "A4",
+		DataDir:  ".resugo",
+	}
+}
+
+// Load builds the effective configuration. Sources are applied from lowest to
+// highest precedence: built-in defaults, user config, project config, then
+// environment variables. When explicitPath is set it is loaded instead of the
+// discovered user and project files. Flags are applied by the caller.
+func Load(explicitPath string) (Config, []string, error) {
+	cfg := Default()
+	var sources []string
+
+	var paths []string
+	if explicitPath == "" {
+		explicitPath = os.Getenv(EnvPrefix + "CONFIG")
+	}
+	if explicitPath != "" {
+		if _, err := os.Stat(explicitPath); err != nil {
+			return cfg, nil, fmt.Errorf("config file %s: %w", explicitPath, err)
+		}
+		paths = []string{explicitPath}
+	} else {
+		if p := UserPath(); p != "" {
+			paths = append(paths, p)
+		}
+		if p := findProjectFile(); p != "" {
+			paths = append(paths, p)
+		}
+	}
+
+	for _, path := range paths {
+		loaded, err := loadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return cfg, nil, err
+		}
+		cfg = cfg.merge(loaded)
+		sources = append(sources, path)
+	}
+
+	cfg.applyEnv()
+
+	if err := cfg.Validate(); err != nil {
+		return cfg, sources, err
+	}
+	return cfg, sources, nil
+}
+
+// UserPath returns the per-user config file path
+func UserPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "resugo", "config.yaml")
+}
+
+// Validate checks enumerated settings
+func (c Config) Validate() error {
+	switch strings.ToLower(c.Locale) {
+	case "", "en", "zh":
+	default:
+		return fmt.Errorf("unsupported locale %q (valid: en, zh)", c.Locale)
+	}
+	switch strings.ToLower(c.PageSize) {
+	case "", "a4", "letter":
+	default:
+		return fmt.Errorf("unsupported page size %q (valid: A4, Letter)", c.PageSize)
+	}
+	if err := c.Layout.Validate(); err != nil {
+		return fmt.Errorf("config %w", err)
+	}
+	if err := c.Lint.Validate(); err != nil {
+		return fmt.Errorf("config %w", err)
+	}
+	if c.Timeline.GapMonths != nil && *c.Timeline.GapMonths < 0 {
+		return fmt.Errorf("timeline.gap_months must not be negative, got %d", *c.Timeline.GapMonths)
+	}
+	return nil
+}
+
+// ApplyAuthor fills empty personal info fields from the configured author
+func (c Config) ApplyAuthor(info *models.PersonalInfo) {
+	fill := func(dst *string, src string) {
+		if strings.TrimSpace(*dst) == "" {
+			*dst = src
+		}
+	}
+	fill(&info.Name, c.Author.Name)
+	fill(&info.Email, c.Author.Email)
+	fill(&info.Phone, c.Author.Phone)
+	fill(&info.Location, c.Author.Location)
+	fill(&info.Website, c.Author.Website)
+}
+
+// merge returns c with every non-empty setting of override applied
+func (c Config) merge(override Config) Config {
+	set := func(dst *string, src string) {
+		if src != "" {
+			*dst = src
+		}
+	}
+	set(&c.Format, override.Format)
+	set(&c.OutputDir, override.OutputDir)
+	set(&c.Theme, override.Theme)
+	set(&c.Locale, override.Locale)
+	set(&c.PageSize, override.PageSize)
+	set(&c.DataDir, override.DataDir)
+	set(&c.Author.Name, override.Author.Name)
+	set(&c.Author.Email, override.Author.Email)
+	set(&c.Author.Phone, override.Author.Phone)
+	set(&c.Author.Location, override.Author.Location)
+	set(&c.Author.Website, override.Author.Website)
+	c.Layout = c.Layout.Merge(override.Layout)
+	if override.Fit.MinFontSize > 0 {
+		c.Fit.MinFontSize = override.Fit.MinFontSize
+	}
+	if len(override.Fit.Strategies) > 0 {
+		c.Fit.Strategies = override.Fit.Strategies
+	}
+	for name, rule := range override.Lint.Rules {
+		if c.Lint.Rules == nil {
+			c.Lint.Rules = make(map[string]lint.RuleConfig)
+		}
+		c.Lint.Rules[name] = rule
+	}
+	if override.Verbose != nil {
+		c.Verbose = override.Verbose
+	}
+	if override.Timeline.GapMonths != nil {
+		c.Timeline.GapMonths = override.Timeline.GapMonths
+	}
+	return c
+}
+
+// applyEnv overrides settings from RESUGO_* environment variables
+func (c *Config) applyEnv() {
+	vars := map[string]*string{
+		"FORMAT":     &c.Format,
+		"OUTPUT_DIR": &c.OutputDir,
+		"THEME":      &c.Theme,
+		"LOCALE":     &c.Locale,
+		"PAGE_SIZE":  &c.PageSize,
+		"DATA_DIR":   &c.DataDir,
+	}
+	for name, dst := range vars {
+		if value, ok := os.LookupEnv(EnvPrefix + name); ok && value != "" {
+			*dst = value
+		}
+	}
+	verbose := true
+	switch strings.ToLower(os.Getenv(EnvPrefix + "VERBOSE")) {
+	case "1", "true", "yes":
+		c.Verbose = &verbose
+	case "0", "false", "no":
+		verbose = false
+		c.Verbose = &verbose
+	}
+}
+
+func loadFile(path string) (Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// findProjectFile looks for ProjectFileName in the working directory and its parents
+func findProjectFile() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		path := filepath.Join(dir, ProjectFileName)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
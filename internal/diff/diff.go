@@ -0,0 +1,349 @@
+package diff
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// Kind is the type of a change
+type Kind string
+
+// Kinds of changes
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Changed Kind = "changed"
+)
+
+// sectionPersonalInfo names the contact fields, which are not a layout section
+const sectionPersonalInfo = "personal_info"
+
+// Change is one difference between two resumes. Entry-level changes have an
+// empty Field; field and bullet changes name the field they belong to.
+type Change struct {
+	Kind    Kind   `json:"kind"`
+	Section string `json:"section"`
+	Subject string `json:"subject,omitempty"` // Entry the change belongs to, e.g. "Acme / Engineer"
+	Field   string `json:"field,omitempty"`
+	Old     string `json:"old,omitempty"`
+	New     string `json:"new,omitempty"`
+}
+
+// Compare reports the structural differences from old to new. Experience is
+// matched by company and position, projects by name, education by institution
+// and degree, languages by name and custom sections by title, so reordering
+// entries does not count as a change.
+func Compare(old, new *models.Resume) []Change {
+	var c collector
+
+	personal := func(field, a, b string) { c.field(sectionPersonalInfo, "", field, a, b) }
+	personal("name", old.PersonalInfo.Name, new.PersonalInfo.Name)
+	personal("title", old.PersonalInfo.Title, new.PersonalInfo.Title)
+	personal("email", old.PersonalInfo.Email, new.PersonalInfo.Email)
+	personal("phone", old.PersonalInfo.Phone, new.PersonalInfo.Phone)
+	personal("location", old.PersonalInfo.Location, new.PersonalInfo.Location)
+	personal("website", old.PersonalInfo.Website, new.PersonalInfo.Website)
+	personal("github", old.PersonalInfo.GitHub, new.PersonalInfo.GitHub)
+	personal("linkedin", old.PersonalInfo.LinkedIn, new.PersonalInfo.LinkedIn)
+	personal("photo", old.PersonalInfo.Photo, new.PersonalInfo.Photo)
+	c.list(sectionPersonalInfo, "", "links", linkNames(old.PersonalInfo.Links), linkNames(new.PersonalInfo.Links))
+
+	c.field(models.SectionSummary, "", "summary", old.Summary, new.Summary)
+
+	compareEntries(&c, models.SectionEducation, old.Education, new.Education,
+		func(e models.Education) string { return joinName(e.Institution, e.Degree) },
+		func(subject string, a, b models.Education) {
+			section := models.SectionEducation
+			c.field(section, subject, "major", a.Major, b.Major)
+			c.field(section, subject, "location", a.Location, b.Location)
+			c.field(section, subject, "start", formatDate(a.StartDate), formatDate(b.StartDate))
+			c.field(section, subject, "end", formatEnd(a.EndDate, a.Current), formatEnd(b.EndDate, b.Current))
+			c.field(section, subject, "gpa", a.GPA, b.GPA)
+			c.field(section, subject, "description", a.Description, b.Description)
+			c.list(section, subject, "relevant_courses", a.RelevantCourses, b.RelevantCourses)
+			c.list(section, subject, "honors_awards", a.HonorsAwards, b.HonorsAwards)
+		})
+
+	compareEntries(&c, models.SectionExperience, old.Experience, new.Experience,
+		func(e models.Experience) string { return joinName(e.Company, e.Position) },
+		func(subject string, a, b models.Experience) {
+			section := models.SectionExperience
+			c.field(section, subject, "location", a.Location, b.Location)
+			c.field(section, subject, "type", a.Type, b.Type)
+			c.field(section, subject, "start", formatDate(a.StartDate), formatDate(b.StartDate))
+			c.field(section, subject, "end", formatEnd(a.EndDate, a.Current), formatEnd(b.EndDate, b.Current))
+			c.list(section, subject, "responsibilities", a.Responsibilities, b.Responsibilities)
+			c.list(section, subject, "achievements", a.Achievements, b.Achievements)
+			c.list(section, subject, "technologies", a.Technologies, b.Technologies)
+			compareEntries(&c, section, a.Roles, b.Roles,
+				func(r models.Role) string { return joinName(subject, r.Position) },
+				func(subject string, a, b models.Role) {
+					c.field(section, subject, "start", formatDate(a.StartDate), formatDate(b.StartDate))
+					c.field(section, subject, "end", formatEnd(a.EndDate, a.Current), formatEnd(b.EndDate, b.Current))
+					c.list(section, subject, "responsibilities", a.Responsibilities, b.Responsibilities)
+					c.list(section, subject, "achievements", a.Achievements, b.Achievements)
+				})
+		})
+
+	compareEntries(&c, models.SectionProjects, old.Projects, new.Projects,
+		func(p models.Project) string { return p.Name },
+		func(subject string, a, b models.Project) {
+			section := models.SectionProjects
+			c.field(section, subject, "description", a.Description, b.Description)
+			c.field(section, subject, "location", a.Location, b.Location)
+			c.field(section, subject, "start", formatDate(a.StartDate), formatDate(b.StartDate))
+			c.field(section, subject, "end", formatEnd(a.EndDate, a.Current), formatEnd(b.EndDate, b.Current))
+			c.field(section, subject, "url", a.URL, b.URL)
+			c.field(section, subject, "repository", a.Repository, b.Repository)
+			c.list(section, subject, "technologies", a.Technologies, b.Technologies)
+			c.list(section, subject, "details", a.Details, b.Details)
+		})
+
+	compareSkills(&c, old.Skills, new.Skills)
+
+	compareEntries(&c, models.SectionCertifications, old.Certifications, new.Certifications,
+		func(cert models.Certification) string { return cert.Name },
+		func(subject string, a, b models.Certification) {
+			section := models.SectionCertifications
+			c.field(section, subject, "issuer", a.Issuer, b.Issuer)
+			c.field(section, subject, "date", formatDate(a.Date), formatDate(b.Date))
+			c.field(section, subject, "expires", formatDate(a.Expires), formatDate(b.Expires))
+			c.field(section, subject, "credential_id", a.CredentialID, b.CredentialID)
+			c.field(section, subject, "url", a.URL, b.URL)
+		})
+
+	compareEntries(&c, models.SectionAwards, old.Awards, new.Awards,
+		func(a models.Award) string { return a.Title },
+		func(subject string, a, b models.Award) {
+			section := models.SectionAwards
+			c.field(section, subject, "issuer", a.Issuer, b.Issuer)
+			c.field(section, subject, "date", formatDate(a.Date), formatDate(b.Date))
+			c.field(section, subject, "description", a.Description, b.Description)
+		})
+
+	compareEntries(&c, models.SectionPublications, old.Publications, new.Publications,
+		func(p models.Publication) string { return p.Title },
+		func(subject string, a, b models.Publication) {
+			section := models.SectionPublications
+			c.list(section, subject, "authors", a.Authors, b.Authors)
+			c.field(section, subject, "venue", a.Venue, b.Venue)
+			c.field(section, subject, "year", formatYear(a.Year), formatYear(b.Year))
+			c.field(section, subject, "doi", a.DOI, b.DOI)
+			c.field(section, subject, "url", a.URL, b.URL)
+		})
+
+	compareEntries(&c, models.SectionVolunteer, old.Volunteer, new.Volunteer,
+		func(v models.Volunteer) string { return joinName(v.Organization, v.Role) },
+		func(subject string, a, b models.Volunteer) {
+			section := models.SectionVolunteer
+			c.field(section, subject, "location", a.Location, b.Location)
+			c.field(section, subject, "start", formatDate(a.StartDate), formatDate(b.StartDate))
+			c.field(section, subject, "end", formatEnd(a.EndDate, a.Current), formatEnd(b.EndDate, b.Current))
+			c.list(section, subject, "details", a.Details, b.Details)
+		})
+
+	compareEntries(&c, models.SectionLanguages, old.Languages, new.Languages,
+		func(l models.Language) string { return l.Name },
+		func(subject string, a, b models.Language) {
+			c.field(models.SectionLanguages, subject, "level", a.Level, b.Level)
+			c.field(models.SectionLanguages, subject, "certification", a.Certification, b.Certification)
+		})
+
+	compareEntries(&c, models.SectionAdditional, old.Additional, new.Additional,
+		func(s models.Section) string { return s.Title },
+		func(subject string, a, b models.Section) {
+			c.list(models.SectionAdditional, subject, "items", a.Items, b.Items)
+		})
+
+	return c.changes
+}
+
+// collector accumulates changes in document order
+type collector struct {
+	changes []Change
+}
+
+// field records a changed scalar value
+func (c *collector) field(section, subject, field, a, b string) {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if a == b {
+		return
+	}
+	c.changes = append(c.changes, Change{Kind: Changed, Section: section, Subject: subject, Field: field, Old: a, New: b})
+}
+
+// list records removed and added items of a list such as bullets
+func (c *collector) list(section, subject, field string, a, b []string) {
+	added, removed := setDiff(a, b)
+	for _, item := range removed {
+		c.changes = append(c.changes, Change{Kind: Removed, Section: section, Subject: subject, Field: field, Old: item})
+	}
+	for _, item := range added {
+		c.changes = append(c.changes, Change{Kind: Added, Section: section, Subject: subject, Field: field, New: item})
+	}
+}
+
+// compareEntries matches the entries of one section by key and reports
+// removed, added and changed entries
+func compareEntries[T any](c *collector, section string, old, new []T, key func(T) string, fields func(subject string, a, b T)) {
+	// Entries sharing a key are paired in order
+	unmatched := make(map[string][]int)
+	for i, entry := range old {
+		k := normalizeKey(key(entry))
+		unmatched[k] = append(unmatched[k], i)
+	}
+
+	type pair struct{ old, new int }
+	var pairs []pair
+	var added []int
+	matched := make(map[int]bool)
+	for i, entry := range new {
+		k := normalizeKey(key(entry))
+		if candidates := unmatched[k]; len(candidates) > 0 {
+			pairs = append(pairs, pair{candidates[0], i})
+			matched[candidates[0]] = true
+			unmatched[k] = candidates[1:]
+			continue
+		}
+		added = append(added, i)
+	}
+
+	for i, entry := range old {
+		if !matched[i] {
+			c.changes = append(c.changes, Change{Kind: Removed, Section: section, Subject: key(entry)})
+		}
+	}
+	for _, i := range added {
+		c.changes = append(c.changes, Change{Kind: Added, Section: section, Subject: key(new[i])})
+	}
+	for _, p := range pairs {
+		fields(key(new[p.new]), old[p.old], new[p.new])
+	}
+}
+
+// compareSkills reports added and removed skills per category, and changed
+// levels and years of the skills kept
+func compareSkills(c *collector, a, b models.Skills) {
+	compareSkillList(c, "languages", a.Languages, b.Languages)
+	compareSkillList(c, "frameworks", a.Frameworks, b.Frameworks)
+	compareSkillList(c, "databases", a.Databases, b.Databases)
+	compareSkillList(c, "tools", a.Tools, b.Tools)
+	compareSkillList(c, "other", a.Other, b.Other)
+
+	custom := func(categories []models.SkillCategory) map[string]models.SkillList {
+		items := make(map[string]models.SkillList)
+		for _, category := range categories {
+			items[category.Name] = append(items[category.Name], category.Items...)
+		}
+		return items
+	}
+	oldCustom, newCustom := custom(a.Custom), custom(b.Custom)
+	var names []string
+	seen := make(map[string]bool)
+	for _, category := range append(append([]models.SkillCategory{}, a.Custom...), b.Custom...) {
+		if !seen[category.Name] {
+			seen[category.Name] = true
+			names = append(names, category.Name)
+		}
+	}
+	for _, name := range names {
+		compareSkillList(c, name, oldCustom[name], newCustom[name])
+	}
+}
+
+// compareSkillList compares one skill category
+func compareSkillList(c *collector, field string, a, b models.SkillList) {
+	section := models.SectionSkills
+	c.list(section, "", field, a.Names(), b.Names())
+	old := make(map[string]models.Skill)
+	for _, skill := range a {
+		old[normalizeKey(skill.Name)] = skill
+	}
+	for _, skill := range b {
+		if prev, ok := old[normalizeKey(skill.Name)]; ok {
+			c.field(section, skill.Name, "level", prev.Level, skill.Level)
+			c.field(section, skill.Name, "years", formatYears(prev.Years), formatYears(skill.Years))
+		}
+	}
+}
+
+// linkNames describes links as "label / url" for comparison
+func linkNames(links []models.Link) []string {
+	names := make([]string, len(links))
+	for i, link := range links {
+		names[i] = joinName(link.Label, link.URL)
+	}
+	return names
+}
+
+// setDiff returns the items only in b and the items only in a, each in
+// their original order; surrounding whitespace is ignored
+func setDiff(a, b []string) (added, removed []string) {
+	count := func(items []string) map[string]int {
+		counts := make(map[string]int)
+		for _, item := range items {
+			counts[strings.TrimSpace(item)]++
+		}
+		return counts
+	}
+	inA, inB := count(a), count(b)
+	for _, item := range a {
+		item = strings.TrimSpace(item)
+		if inB[item] > 0 {
+			inB[item]--
+			continue
+		}
+		removed = append(removed, item)
+	}
+	for _, item := range b {
+		item = strings.TrimSpace(item)
+		if inA[item] > 0 {
+			inA[item]--
+			continue
+		}
+		added = append(added, item)
+	}
+	return added, removed
+}
+
+func joinName(name, role string) string {
+	if role == "" {
+		return name
+	}
+	return name + " / " + role
+}
+
+func normalizeKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01")
+}
+
+func formatYear(year int) string {
+	if year == 0 {
+		return ""
+	}
+	return strconv.Itoa(year)
+}
+
+func formatYears(years float64) string {
+	if years == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(years, 'f', -1, 64)
+}
+
+func formatEnd(t time.Time, current bool) string {
+	if current {
+		return "present"
+	}
+	return formatDate(t)
+}
@@ -0,0 +1,149 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ANSI colors used by WriteText
+const (
+	colorReset  = "\033[0m"
+	colorBold   = "\033[1m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// group is a run of changes sharing section and subject
+type group struct {
+	section string
+	subject string
+	changes []Change
+}
+
+// groupChanges splits changes into consecutive runs with the same section
+// and subject
+func groupChanges(changes []Change) []group {
+	var groups []group
+	for _, change := range changes {
+		if n := len(groups); n > 0 && groups[n-1].section == change.Section && groups[n-1].subject == change.Subject {
+			groups[n-1].changes = append(groups[n-1].changes, change)
+			continue
+		}
+		groups = append(groups, group{change.Section, change.Subject, []Change{change}})
+	}
+	return groups
+}
+
+// entryChange returns the entry-level change of a group, if any
+func (g group) entryChange() (Change, bool) {
+	if g.subject != "" && len(g.changes) == 1 && g.changes[0].Field == "" {
+		return g.changes[0], true
+	}
+	return Change{}, false
+}
+
+// WriteText writes changes as indented terminal text, optionally colored
+func WriteText(w io.Writer, changes []Change, color bool) {
+	paint := func(c, s string) string {
+		if !color {
+			return s
+		}
+		return c + s + colorReset
+	}
+	marks := map[Kind]string{
+		Added:   paint(colorGreen, "+"),
+		Removed: paint(colorRed, "-"),
+		Changed: paint(colorYellow, "~"),
+	}
+
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+
+	section := ""
+	for _, g := range groupChanges(changes) {
+		if g.section != section {
+			section = g.section
+			fmt.Fprintln(w, paint(colorBold, section))
+		}
+		if change, ok := g.entryChange(); ok {
+			fmt.Fprintf(w, "  %s %s\n", marks[change.Kind], change.Subject)
+			continue
+		}
+		indent := "  "
+		if g.subject != "" {
+			fmt.Fprintf(w, "  %s %s\n", marks[Changed], g.subject)
+			indent = "      "
+		}
+		for _, change := range g.changes {
+			switch change.Kind {
+			case Changed:
+				fmt.Fprintf(w, "%s%s %s: %s → %s\n", indent, marks[Changed], change.Field,
+					paint(colorRed, quote(change.Old)), paint(colorGreen, quote(change.New)))
+			case Added:
+				fmt.Fprintf(w, "%s%s %s: %s\n", indent, marks[Added], change.Field, paint(colorGreen, change.New))
+			case Removed:
+				fmt.Fprintf(w, "%s%s %s: %s\n", indent, marks[Removed], change.Field, paint(colorRed, change.Old))
+			}
+		}
+	}
+}
+
+// WriteMarkdown writes changes as a Markdown list grouped by section
+func WriteMarkdown(w io.Writer, changes []Change) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+
+	verbs := map[Kind]string{Added: "Added", Removed: "Removed", Changed: "Changed"}
+	section := ""
+	for _, g := range groupChanges(changes) {
+		if g.section != section {
+			if section != "" {
+				fmt.Fprintln(w)
+			}
+			section = g.section
+			fmt.Fprintf(w, "### %s\n\n", heading(section))
+		}
+		if change, ok := g.entryChange(); ok {
+			fmt.Fprintf(w, "- **%s** %s\n", verbs[change.Kind], change.Subject)
+			continue
+		}
+		indent := ""
+		if g.subject != "" {
+			fmt.Fprintf(w, "- **Changed** %s\n", g.subject)
+			indent = "  "
+		}
+		for _, change := range g.changes {
+			switch change.Kind {
+			case Changed:
+				fmt.Fprintf(w, "%s- %s: ~~%s~~ → %s\n", indent, change.Field, quote(change.Old), quote(change.New))
+			case Added:
+				fmt.Fprintf(w, "%s- added %s: %s\n", indent, change.Field, change.New)
+			case Removed:
+				fmt.Fprintf(w, "%s- removed %s: ~~%s~~\n", indent, change.Field, change.Old)
+			}
+		}
+	}
+}
+
+// quote marks empty values so that clearing a field stays visible
+func quote(s string) string {
+	if s == "" {
+		return "(empty)"
+	}
+	return s
+}
+
+// heading turns a section key such as "personal_info" into "Personal info"
+func heading(key string) string {
+	s := strings.ReplaceAll(key, "_", " ")
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/pdf"
+)
+
+// contact is one item of the contact line under the name
+type contact struct {
+	Text string
+	URL  string // Set for links
+	Icon string
+}
+
+// contacts returns the contact details shown under the name: phone, email,
+// location, website and profiles, then the custom links
+func (g *Generator) contacts() []contact {
+	info := g.resume.PersonalInfo
+	var items []contact
+	for _, value := range []string{info.Phone, info.Email, info.Location} {
+		if value != "" {
+			items = append(items, contact{Text: value})
+		}
+	}
+	if info.Website != "" {
+		items = append(items, contact{Text: info.Website, URL: withScheme(info.Website)})
+	}
+	if info.GitHub != "" {
+		items = append(items, profile(info.GitHub, "github.com/"))
+	}
+	if info.LinkedIn != "" {
+		items = append(items, profile(info.LinkedIn, "linkedin.com/in/"))
+	}
+	for _, link := range info.Links {
+		if link.URL == "" {
+			continue
+		}
+		text := link.Label
+		if text == "" {
+			text = strings.TrimPrefix(strings.TrimPrefix(link.URL, "https://"), "http://")
+		}
+		items = append(items, contact{Text: text, URL: withScheme(link.URL), Icon: link.Icon})
+	}
+	return items
+}
+
+// contactParts returns the contact details as plain text
+func (g *Generator) contactParts() []string {
+	var parts []string
+	for _, c := range g.contacts() {
+		parts = append(parts, c.Text)
+	}
+	return parts
+}
+
+// markdown formats the contact as a Markdown link when it has a URL
+func (c contact) markdown() string {
+	text := c.Text
+	if c.URL != "" {
+		text = fmt.Sprintf("[%s](%s)", c.Text, c.URL)
+	}
+	if c.Icon != "" {
+		text = c.Icon + " " + text
+	}
+	return text
+}
+
+// profile builds a contact from a profile value that is either a URL or a
+// bare username on the site at prefix
+func profile(value, prefix string) contact {
+	if strings.ContainsAny(value, "./") {
+		return contact{Text: value, URL: withScheme(value)}
+	}
+	return contact{Text: prefix + value, URL: "https://" + prefix + value}
+}
+
+// withScheme adds https:// to addresses written without a scheme
+func withScheme(url string) string {
+	if strings.Contains(url, "://") || strings.HasPrefix(url, "mailto:") {
+		return url
+	}
+	return "https://" + url
+}
+
+// readPhoto returns the photo file contents, or nil when no photo is set
+func (g *Generator) readPhoto() ([]byte, error) {
+	path := g.resume.PhotoPath()
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read photo: %w", err)
+	}
+	return data, nil
+}
+
+// photoURL returns the photo as a data URL for embedding in HTML
+func (g *Generator) photoURL() (template.URL, error) {
+	data, err := g.readPhoto()
+	if err != nil || data == nil {
+		return "", err
+	}
+	mime := http.DetectContentType(data)
+	if mime != "image/jpeg" && mime != "image/png" {
+		return "", fmt.Errorf("photo %s must be a JPEG or PNG image", g.resume.PersonalInfo.Photo)
+	}
+	return template.URL("data:" + mime + ";base64," + base64.StdEncoding.EncodeToString(data)), nil
+}
+
+// pdfPhoto decodes the photo for embedding in PDF
+func (g *Generator) pdfPhoto() (*pdf.Image, error) {
+	data, err := g.readPhoto()
+	if err != nil || data == nil {
+		return nil, err
+	}
+	img, err := pdf.DecodeImage(data)
+	if err != nil {
+		return nil, fmt.Errorf("photo %s: %w", g.resume.PersonalInfo.Photo, err)
+	}
+	return img, nil
+}
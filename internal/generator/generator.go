@@ -2,6 +2,8 @@ package generator
 
 import (
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,23 +14,64 @@ import (
 
 // Generator handles resume generation in different formats
 type Generator struct {
-	resume *models.Resume
+	resume        *models.Resume
+	locale        string
+	pageSize      string
+	defaultLayout models.Layout
+	logger        *slog.Logger
+}
+
+// Option configures a Generator
+type Option func(*Generator)
+
+// WithLocale sets the language of fixed headings and labels ("en" or "zh")
+func WithLocale(locale string) Option {
+	return func(g *Generator) {
+		g.locale = strings.ToLower(locale)
+	}
+}
+
+// WithPageSize sets the default paper size of paginated formats ("A4" or "Letter")
+func WithPageSize(size string) Option {
+	return func(g *Generator) {
+		g.pageSize = size
+	}
+}
+
+// WithDefaultLayout sets a layout that the resume's own layout is merged onto
+func WithDefaultLayout(layout models.Layout) Option {
+	return func(g *Generator) {
+		g.defaultLayout = layout
+	}
+}
+
+// WithLogger sets the logger used for progress output
+func WithLogger(logger *slog.Logger) Option {
+	return func(g *Generator) {
+		g.logger = logger
+	}
 }
 
 // NewGenerator creates a new generator instance
-func NewGenerator(resume *models.Resume) *Generator {
-	return &Generator{
+func NewGenerator(resume *models.Resume, opts ...Option) *Generator {
+	g := &Generator{
 		resume: resume,
+		locale: "en",
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	for _, opt := range opts {
+		opt(g)
 	}
+	return g
 }
 
-// GenerateYAML generates resume in YAML format
-func (g *Generator) GenerateYAML(outputPath string) error {
-	data, err := yaml.Marshal(g.resume)
-	if err != nil {
-		return fmt.Errorf("failed to marshal resume to YAML: %w", err)
-	}
+// layout returns the effective layout for this resume
+func (g *Generator) layout() models.Layout {
+	return g.defaultLayout.Merge(g.resume.Layout)
+}
 
+// writeFile writes generated content, creating the parent directory if needed
+func (g *Generator) writeFile(outputPath string, data []byte, format string) error {
 	// Create directory if it doesn't exist
 	dir := filepath.Dir(outputPath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -37,69 +80,182 @@ func (g *Generator) GenerateYAML(outputPath string) error {
 
 	// Write to file
 	if err := os.WriteFile(outputPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write YAML file: %w", err)
+		return fmt.Errorf("failed to write %s file: %w", format, err)
 	}
 
+	g.logger.Info("wrote output", "format", format, "path", outputPath, "bytes", len(data))
 	return nil
 }
 
+// GenerateYAML generates resume in YAML format
+func (g *Generator) GenerateYAML(outputPath string) error {
+	data, err := g.RenderYAML()
+	if err != nil {
+		return err
+	}
+	return g.writeFile(outputPath, data, "YAML")
+}
+
+// RenderYAML renders the resume as YAML
+func (g *Generator) RenderYAML() ([]byte, error) {
+	if err := g.layout().Validate(); err != nil {
+		return nil, err
+	}
+
+	g.logger.Debug("marshaling resume", "format", "yaml")
+	data, err := yaml.Marshal(g.resume)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resume to YAML: %w", err)
+	}
+	return data, nil
+}
+
 // GenerateMarkdown generates resume in Markdown format
 func (g *Generator) GenerateMarkdown(outputPath string) error {
-	content := g.buildMarkdownContent()
+	data, err := g.RenderMarkdown()
+	if err != nil {
+		return err
+	}
+	return g.writeFile(outputPath, data, "Markdown")
+}
 
-	// Create directory if it doesn't exist
-	dir := filepath.Dir(outputPath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+// RenderMarkdown renders the resume as Markdown
+func (g *Generator) RenderMarkdown() ([]byte, error) {
+	if err := g.layout().Validate(); err != nil {
+		return nil, err
 	}
+	return []byte(g.buildMarkdownContent()), nil
+}
 
-	// Write to file
-	if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
-		return fmt.Errorf("failed to write Markdown file: %w", err)
+// Formats lists the supported output formats
+var Formats = []string{"yaml", "markdown", "html", "pdf"}
+
+// Extension returns the file extension used for a format
+func Extension(format string) string {
+	switch format {
+	case "markdown", "md":
+		return ".md"
+	default:
+		return "." + format
 	}
+}
 
-	return nil
+// Render renders the resume in the named format. theme applies to styled formats.
+func (g *Generator) Render(format, theme string) ([]byte, error) {
+	switch format {
+	case "yaml":
+		return g.RenderYAML()
+	case "markdown", "md":
+		return g.RenderMarkdown()
+	case "html":
+		return g.RenderHTML(theme, "")
+	case "pdf":
+		data, _, err := g.RenderPDF(PDFOptions{Theme: theme})
+		return data, err
+	default:
+		return nil, fmt.Errorf("unsupported output format: %s", format)
+	}
 }
 
 func (g *Generator) buildMarkdownContent() string {
 	var content strings.Builder
-	r := g.resume
+	content.WriteString(g.markdownHeader())
+
+	// Sections in layout order, separated by horizontal rules
+	var sections []string
+	for _, key := range g.layout().Order() {
+		g.logger.Debug("rendering section", "format", "markdown", "section", key)
+		sections = append(sections, g.buildMarkdownSections(key)...)
+	}
+	content.WriteString(strings.Join(sections, "---\n\n"))
+
+	return content.String()
+}
+
+// markdownHeader returns the centered name, title and contact line that open
+// both the resume and the cover letter
+func (g *Generator) markdownHeader() string {
+	var content strings.Builder
 
 	// Header - Centered name
-	content.WriteString(fmt.Sprintf("<div align=\"center\">\n\n# %s\n\n", r.PersonalInfo.Name))
+	content.WriteString(fmt.Sprintf("<div align=\"center\">\n\n# %s\n\n", g.resume.PersonalInfo.Name))
+	if title := g.resume.PersonalInfo.Title; title != "" {
+		content.WriteString(fmt.Sprintf("**%s**\n\n", title))
+	}
 
 	// Contact information in one line
 	var contactParts []string
-	if r.PersonalInfo.Phone != "" {
-		contactParts = append(contactParts, r.PersonalInfo.Phone)
-	}
-	if r.PersonalInfo.Email != "" {
-		contactParts = append(contactParts, r.PersonalInfo.Email)
-	}
-	if r.PersonalInfo.Location != "" {
-		contactParts = append(contactParts, r.PersonalInfo.Location)
-	}
-	if r.PersonalInfo.Website != "" {
-		contactParts = append(contactParts, r.PersonalInfo.Website)
+	for _, c := range g.contacts() {
+		contactParts = append(contactParts, c.markdown())
 	}
-
 	if len(contactParts) > 0 {
 		content.WriteString(strings.Join(contactParts, " | "))
 		content.WriteString("\n\n</div>\n\n")
 	} else {
 		content.WriteString("</div>\n\n")
 	}
+	return content.String()
+}
 
-	// Summary section
-	if r.Summary != "" {
-		content.WriteString("## Summary\n\n")
-		content.WriteString(fmt.Sprintf("%s\n\n", r.Summary))
-		content.WriteString("---\n\n")
+// writeBullets lists responsibilities followed by labelled achievements
+func (g *Generator) writeBullets(content *strings.Builder, responsibilities, achievements []string) {
+	for _, resp := range responsibilities {
+		content.WriteString(fmt.Sprintf("• %s\n", resp))
 	}
+	for _, achievement := range achievements {
+		content.WriteString(fmt.Sprintf("• **%s:** %s\n", g.label("achievement"), achievement))
+	}
+}
+
+// hasContent reports whether the section identified by key has anything to render
+func (g *Generator) hasContent(key string) bool {
+	r := g.resume
+	switch key {
+	case models.SectionSummary:
+		return r.Summary != ""
+	case models.SectionEducation:
+		return len(r.Education) > 0
+	case models.SectionExperience:
+		return len(r.Experience) > 0
+	case models.SectionProjects:
+		return len(r.Projects) > 0
+	case models.SectionSkills:
+		return !r.Skills.IsEmpty()
+	case models.SectionCertifications:
+		return len(r.Certifications) > 0
+	case models.SectionAwards:
+		return len(r.Awards) > 0
+	case models.SectionPublications:
+		return len(r.Publications) > 0
+	case models.SectionVolunteer:
+		return len(r.Volunteer) > 0
+	case models.SectionLanguages:
+		return len(r.Languages) > 0
+	case models.SectionAdditional:
+		return len(r.Additional) > 0
+	}
+	return false
+}
 
-	// Education section
-	if len(r.Education) > 0 {
-		content.WriteString("## Education\n\n")
+// buildMarkdownSections renders the section identified by key. Empty sections
+// yield nothing; "additional" may yield one block per custom section.
+func (g *Generator) buildMarkdownSections(key string) []string {
+	r := g.resume
+	var content strings.Builder
+
+	switch key {
+	case models.SectionSummary:
+		if r.Summary == "" {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
+		content.WriteString(fmt.Sprintf("%s\n\n", r.Summary))
+
+	case models.SectionEducation:
+		if len(r.Education) == 0 {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
 		for _, edu := range r.Education {
 			// Degree line
 			content.WriteString(fmt.Sprintf("**%s**", edu.Degree))
@@ -108,11 +264,13 @@ func (g *Generator) buildMarkdownContent() string {
 			}
 
 			// Institution and dates on right
-			content.WriteString(fmt.Sprintf("%s%s - %s\n",
-				strings.Repeat(" ", 50), edu.FormatStartDate(), edu.FormatEndDate()))
+			if dates := edu.DateRange(); dates != "" {
+				content.WriteString(strings.Repeat(" ", 50) + dates)
+			}
+			content.WriteString("\n")
 
 			// Institution name and location
-			content.WriteString(fmt.Sprintf("%s", edu.Institution))
+			content.WriteString(edu.Institution)
 			if edu.Location != "" {
 				content.WriteString(fmt.Sprintf("%s%s\n",
 					strings.Repeat(" ", 40), edu.Location))
@@ -122,33 +280,58 @@ func (g *Generator) buildMarkdownContent() string {
 
 			// Additional details
 			if len(edu.RelevantCourses) > 0 {
-				content.WriteString("• **Relevant Courses:** ")
+				content.WriteString(fmt.Sprintf("• **%s:** ", g.label("relevant_courses")))
 				content.WriteString(strings.Join(edu.RelevantCourses, ", "))
 				content.WriteString("\n")
 			}
 
 			if len(edu.HonorsAwards) > 0 {
-				content.WriteString("• **Honors & Awards:** ")
+				content.WriteString(fmt.Sprintf("• **%s:** ", g.label("honors_awards")))
 				content.WriteString(strings.Join(edu.HonorsAwards, ", "))
 				content.WriteString("\n")
 			}
 
 			content.WriteString("\n")
 		}
-		content.WriteString("---\n\n")
-	}
 
-	// Experience section
-	if len(r.Experience) > 0 {
-		content.WriteString("## Experience\n\n")
+	case models.SectionExperience:
+		if len(r.Experience) == 0 {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
 		for _, exp := range r.Experience {
+			if exp.HasRoles() {
+				// Company and overall tenure, then each role in turn
+				content.WriteString(fmt.Sprintf("**%s**", exp.Company))
+				if dates := exp.DateRange(); dates != "" {
+					content.WriteString(strings.Repeat(" ", 50) + dates)
+				}
+				content.WriteString("\n")
+				if exp.Location != "" {
+					content.WriteString(exp.Location + "\n")
+				}
+				g.writeBullets(&content, exp.Responsibilities, exp.Achievements)
+				for _, role := range exp.Roles {
+					content.WriteString(fmt.Sprintf("\n*%s*", role.Position))
+					if dates := role.DateRange(); dates != "" {
+						content.WriteString(strings.Repeat(" ", 40) + dates)
+					}
+					content.WriteString("\n")
+					g.writeBullets(&content, role.Responsibilities, role.Achievements)
+				}
+				content.WriteString("\n")
+				continue
+			}
+
 			// Position and dates
 			content.WriteString(fmt.Sprintf("**%s**", exp.Position))
-			content.WriteString(fmt.Sprintf("%s%s - %s\n",
-				strings.Repeat(" ", 50), exp.FormatStartDate(), exp.FormatEndDate()))
+			if dates := exp.DateRange(); dates != "" {
+				content.WriteString(strings.Repeat(" ", 50) + dates)
+			}
+			content.WriteString("\n")
 
 			// Company and location
-			content.WriteString(fmt.Sprintf("%s", exp.Company))
+			content.WriteString(exp.Company)
 			if exp.Location != "" {
 				content.WriteString(fmt.Sprintf("%s%s\n",
 					strings.Repeat(" ", 40), exp.Location))
@@ -156,32 +339,27 @@ func (g *Generator) buildMarkdownContent() string {
 				content.WriteString("\n")
 			}
 
-			// Responsibilities/Description
-			for _, resp := range exp.Responsibilities {
-				content.WriteString(fmt.Sprintf("• %s\n", resp))
-			}
-
-			// Achievements
-			for _, achievement := range exp.Achievements {
-				content.WriteString(fmt.Sprintf("• **Achievement:** %s\n", achievement))
-			}
+			// Responsibilities/Description and achievements
+			g.writeBullets(&content, exp.Responsibilities, exp.Achievements)
 
 			content.WriteString("\n")
 		}
-		content.WriteString("---\n\n")
-	}
 
-	// Projects section
-	if len(r.Projects) > 0 {
-		content.WriteString("## Projects\n\n")
+	case models.SectionProjects:
+		if len(r.Projects) == 0 {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
 		for _, project := range r.Projects {
 			// Project name and dates
 			content.WriteString(fmt.Sprintf("**%s**", project.Name))
-			content.WriteString(fmt.Sprintf("%s%s - %s\n",
-				strings.Repeat(" ", 50), project.FormatStartDate(), project.FormatEndDate()))
+			if dates := project.DateRange(); dates != "" {
+				content.WriteString(strings.Repeat(" ", 50) + dates)
+			}
+			content.WriteString("\n")
 
 			// Description
-			content.WriteString(fmt.Sprintf("%s", project.Description))
+			content.WriteString(project.Description)
 			if project.Location != "" {
 				content.WriteString(fmt.Sprintf("%s%s\n",
 					strings.Repeat(" ", 40), project.Location))
@@ -196,58 +374,135 @@ func (g *Generator) buildMarkdownContent() string {
 
 			content.WriteString("\n")
 		}
-		content.WriteString("---\n\n")
-	}
 
-	// Skills section
-	content.WriteString("## Skills\n\n")
+	case models.SectionSkills:
+		if r.Skills.IsEmpty() {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
 
-	if len(r.Skills.Languages) > 0 {
-		content.WriteString(fmt.Sprintf("• **Languages:** %s\n",
-			strings.Join(r.Skills.Languages, ", ")))
-	}
+		// Levels and years are written out as text, e.g. "Go (Expert, 5 yrs)"
+		for _, group := range g.skillGroups(r.Skills) {
+			content.WriteString(fmt.Sprintf("• **%s:** %s\n", group.Name, g.skillsText(group.Skills)))
+		}
+		content.WriteString("\n")
 
-	if len(r.Skills.Frameworks) > 0 {
-		content.WriteString(fmt.Sprintf("• **Frameworks:** %s\n",
-			strings.Join(r.Skills.Frameworks, ", ")))
-	}
+	case models.SectionCertifications:
+		if len(r.Certifications) == 0 {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
+		for _, cert := range r.Certifications {
+			// Name, linked to the verification page, and date
+			name := fmt.Sprintf("**%s**", cert.Name)
+			if cert.URL != "" {
+				name = fmt.Sprintf("**[%s](%s)**", cert.Name, cert.URL)
+			}
+			content.WriteString(name)
+			if date := cert.FormatDate(); date != "" {
+				content.WriteString(strings.Repeat(" ", 50) + date)
+			}
+			content.WriteString("\n")
 
-	if len(r.Skills.Databases) > 0 {
-		content.WriteString(fmt.Sprintf("• **Databases:** %s\n",
-			strings.Join(r.Skills.Databases, ", ")))
-	}
+			// Issuer and expiry
+			content.WriteString(cert.Issuer)
+			if expires := cert.FormatExpires(); expires != "" {
+				content.WriteString(strings.Repeat(" ", 40) + fmt.Sprintf(g.label("expires"), expires))
+			}
+			content.WriteString("\n")
 
-	if len(r.Skills.Tools) > 0 {
-		content.WriteString(fmt.Sprintf("• **Tools:** %s\n",
-			strings.Join(r.Skills.Tools, ", ")))
-	}
+			if cert.CredentialID != "" {
+				content.WriteString(fmt.Sprintf("• **%s:** %s\n", g.label("credential_id"), cert.CredentialID))
+			}
+			content.WriteString("\n")
+		}
 
-	if len(r.Skills.Other) > 0 {
-		content.WriteString(fmt.Sprintf("• **Other:** %s\n",
-			strings.Join(r.Skills.Other, ", ")))
-	}
+	case models.SectionAwards:
+		if len(r.Awards) == 0 {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
+		for _, award := range r.Awards {
+			content.WriteString(fmt.Sprintf("**%s**", award.Title))
+			if date := award.FormatDate(); date != "" {
+				content.WriteString(strings.Repeat(" ", 50) + date)
+			}
+			content.WriteString("\n")
+			if award.Issuer != "" {
+				content.WriteString(award.Issuer + "\n")
+			}
+			if award.Description != "" {
+				content.WriteString(fmt.Sprintf("• %s\n", award.Description))
+			}
+			content.WriteString("\n")
+		}
 
-	// Custom skill categories
-	for _, customSkill := range r.Skills.Custom {
-		content.WriteString(fmt.Sprintf("• **%s:** %s\n",
-			customSkill.Name, strings.Join(customSkill.Items, ", ")))
-	}
+	case models.SectionPublications:
+		if len(r.Publications) == 0 {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
+		for _, pub := range r.Publications {
+			citation := pub.Citation()
+			if pub.URL != "" {
+				citation = strings.Replace(citation, pub.Title, fmt.Sprintf("[%s](%s)", pub.Title, pub.URL), 1)
+			}
+			content.WriteString(fmt.Sprintf("• %s\n", citation))
+		}
+		content.WriteString("\n")
 
-	// Languages section (if any)
-	if len(r.Languages) > 0 {
-		content.WriteString("\n---\n\n## Languages\n\n")
-		for _, lang := range r.Languages {
-			content.WriteString(fmt.Sprintf("• **%s:** %s\n", lang.Name, lang.Level))
+	case models.SectionVolunteer:
+		if len(r.Volunteer) == 0 {
+			return nil
 		}
-	}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
+		for _, v := range r.Volunteer {
+			// Role and dates
+			content.WriteString(fmt.Sprintf("**%s**", v.Role))
+			if dates := v.DateRange(); dates != "" {
+				content.WriteString(strings.Repeat(" ", 50) + dates)
+			}
+			content.WriteString("\n")
 
-	// Additional sections
-	for _, section := range r.Additional {
-		content.WriteString(fmt.Sprintf("\n---\n\n## %s\n\n", section.Title))
-		for _, item := range section.Items {
-			content.WriteString(fmt.Sprintf("• %s\n", item))
+			// Organization and location
+			content.WriteString(v.Organization)
+			if v.Location != "" {
+				content.WriteString(fmt.Sprintf("%s%s\n",
+					strings.Repeat(" ", 40), v.Location))
+			} else {
+				content.WriteString("\n")
+			}
+
+			for _, detail := range v.Details {
+				content.WriteString(fmt.Sprintf("• %s\n", detail))
+			}
+			content.WriteString("\n")
+		}
+
+	case models.SectionLanguages:
+		if len(r.Languages) == 0 {
+			return nil
+		}
+		content.WriteString(fmt.Sprintf("## %s\n\n", g.title(key)))
+		for _, lang := range r.Languages {
+			content.WriteString(fmt.Sprintf("• **%s:** %s\n", lang.Name, g.languageText(lang)))
+		}
+		content.WriteString("\n")
+
+	case models.SectionAdditional:
+		// Each custom section is its own block under its own title
+		var blocks []string
+		for _, section := range r.Additional {
+			var block strings.Builder
+			block.WriteString(fmt.Sprintf("## %s\n\n", section.Title))
+			for _, item := range section.Items {
+				block.WriteString(fmt.Sprintf("• %s\n", item))
+			}
+			block.WriteString("\n")
+			blocks = append(blocks, block.String())
 		}
+		return blocks
 	}
 
-	return content.String()
+	return []string{content.String()}
 }
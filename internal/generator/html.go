@@ -0,0 +1,315 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// htmlSection is a section to render, in layout order
+type htmlSection struct {
+	Key   string
+	Title string
+}
+
+// htmlPageHead opens the document and renders the header shared by the
+// resume and the cover letter. Views must provide Resume, Lang, CSS, Contacts
+// and Photo.
+const htmlPageHead = `{{define "page-head"}}<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Resume.PersonalInfo.Name}}</title>
+<style>{{.CSS}}</style>
+</head>
+<body>
+<header>
+{{- if .Photo}}
+<img class="photo" src="{{.Photo}}" alt="">
+{{- end}}
+<h1>{{.Resume.PersonalInfo.Name}}</h1>
+{{- with .Resume.PersonalInfo.Title}}
+<div class="title">{{.}}</div>
+{{- end}}
+{{- if .Contacts}}
+<div class="contact">
+{{- range $i, $c := .Contacts}}{{if $i}} | {{end}}{{with $c.Icon}}{{.}} {{end}}
+{{- if $c.URL}}<a href="{{$c.URL}}">{{$c.Text}}</a>{{else}}{{$c.Text}}{{end}}
+{{- end -}}
+</div>
+{{- end}}
+</header>
+{{- end}}`
+
+// htmlBullets lists the responsibilities and achievements of an experience
+// or of one of its roles
+const htmlBullets = `{{define "bullets"}}
+{{- if or .Responsibilities .Achievements}}
+<ul>
+{{- range .Responsibilities}}
+<li>{{.}}</li>
+{{- end}}
+{{- range .Achievements}}
+<li><strong>{{label "achievement"}}:</strong> {{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- end}}`
+
+// htmlData is the view model passed to htmlTemplate
+type htmlData struct {
+	Resume   *models.Resume
+	Lang     string
+	CSS      template.CSS
+	Contacts []contact
+	Photo    template.URL // Data URL of the photo, if any
+	Sections []htmlSection
+	Extra    template.HTML // Injected before </body>, e.g. a live reload script
+}
+
+var htmlTemplate = template.Must(template.New("resume").Funcs(template.FuncMap{
+	"join":  strings.Join,
+	"label": func(string) string { return "" }, // Replaced per generator in RenderHTML
+	// Also replaced per generator, as they depend on the locale
+	"skillGroups":  func(models.Skills) []skillGroup { return nil },
+	"skillLevel":   func(models.Skill) string { return "" },
+	"skillYears":   func(models.Skill) string { return "" },
+	"skillsText":   func(models.SkillList) string { return "" },
+	"levelSteps":   levelSteps,
+	"languageText": func(models.Language) string { return "" },
+}).Parse(htmlPageHead + htmlBullets + `{{template "page-head" .}}
+{{- $r := .Resume}}
+{{- range .Sections}}
+{{- if eq .Key "summary"}}
+<section class="summary">
+<h2>{{.Title}}</h2>
+<p>{{$r.Summary}}</p>
+</section>
+{{- else if eq .Key "education"}}
+<section class="education">
+<h2>{{.Title}}</h2>
+{{- range $r.Education}}
+<div class="entry">
+<div class="entry-head"><strong>{{.Degree}}{{if .Major}} · {{.Major}}{{end}}</strong><span>{{.DateRange}}</span></div>
+<div class="entry-sub"><span>{{.Institution}}</span><span>{{.Location}}</span></div>
+{{- if or .RelevantCourses .HonorsAwards}}
+<ul>
+{{- if .RelevantCourses}}<li><strong>{{label "relevant_courses"}}:</strong> {{join .RelevantCourses ", "}}</li>{{end}}
+{{- if .HonorsAwards}}<li><strong>{{label "honors_awards"}}:</strong> {{join .HonorsAwards ", "}}</li>{{end}}
+</ul>
+{{- end}}
+</div>
+{{- end}}
+</section>
+{{- else if eq .Key "experience"}}
+<section class="experience">
+<h2>{{.Title}}</h2>
+{{- range $r.Experience}}
+<div class="entry">
+{{- if .HasRoles}}
+<div class="entry-head"><strong>{{.Company}}</strong><span>{{.DateRange}}</span></div>
+{{- if .Location}}
+<div class="entry-sub"><span>{{.Location}}</span></div>
+{{- end}}
+{{- template "bullets" .}}
+{{- range .Roles}}
+<div class="role">
+<div class="role-head"><span>{{.Position}}</span><span>{{.DateRange}}</span></div>
+{{- template "bullets" .}}
+</div>
+{{- end}}
+{{- else}}
+<div class="entry-head"><strong>{{.Position}}</strong><span>{{.DateRange}}</span></div>
+<div class="entry-sub"><span>{{.Company}}</span><span>{{.Location}}</span></div>
+{{- template "bullets" .}}
+{{- end}}
+</div>
+{{- end}}
+</section>
+{{- else if eq .Key "projects"}}
+<section class="projects">
+<h2>{{.Title}}</h2>
+{{- range $r.Projects}}
+<div class="entry">
+<div class="entry-head"><strong>{{.Name}}</strong><span>{{.DateRange}}</span></div>
+<div class="entry-sub"><span>{{.Description}}</span><span>{{.Location}}</span></div>
+{{- if .Details}}
+<ul>
+{{- range .Details}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</div>
+{{- end}}
+</section>
+{{- else if eq .Key "skills"}}
+<section class="skills">
+<h2>{{.Title}}</h2>
+{{- if $r.Skills.HasLevels}}
+<div class="skill-matrix">
+{{- range skillGroups $r.Skills}}
+<h3>{{.Name}}</h3>
+<table>
+{{- range .Skills}}
+<tr><td class="skill-name">{{.Name}}</td><td class="skill-level"{{with skillLevel .}} title="{{.}}"{{end}}>{{if .Rank}}{{range levelSteps .Rank}}<span{{if .}} class="on"{{end}}></span>{{end}}{{end}}</td><td class="skill-years">{{skillYears .}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</div>
+{{- else}}
+<ul>
+{{- range skillGroups $r.Skills}}
+<li><strong>{{.Name}}:</strong> {{skillsText .Skills}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</section>
+{{- else if eq .Key "certifications"}}
+<section class="certifications">
+<h2>{{.Title}}</h2>
+{{- range $r.Certifications}}
+<div class="entry">
+<div class="entry-head"><strong>{{if .URL}}<a href="{{.URL}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}</strong><span>{{.FormatDate}}</span></div>
+<div class="entry-sub"><span>{{.Issuer}}</span><span>{{with .FormatExpires}}{{printf (label "expires") .}}{{end}}</span></div>
+{{- if .CredentialID}}
+<ul>
+<li><strong>{{label "credential_id"}}:</strong> {{.CredentialID}}</li>
+</ul>
+{{- end}}
+</div>
+{{- end}}
+</section>
+{{- else if eq .Key "awards"}}
+<section class="awards">
+<h2>{{.Title}}</h2>
+{{- range $r.Awards}}
+<div class="entry">
+<div class="entry-head"><strong>{{.Title}}</strong><span>{{.FormatDate}}</span></div>
+{{- if .Issuer}}
+<div class="entry-sub"><span>{{.Issuer}}</span></div>
+{{- end}}
+{{- if .Description}}
+<p>{{.Description}}</p>
+{{- end}}
+</div>
+{{- end}}
+</section>
+{{- else if eq .Key "publications"}}
+<section class="publications">
+<h2>{{.Title}}</h2>
+<ul>
+{{- range $r.Publications}}
+<li>{{if .URL}}<a href="{{.URL}}">{{.Citation}}</a>{{else}}{{.Citation}}{{end}}</li>
+{{- end}}
+</ul>
+</section>
+{{- else if eq .Key "volunteer"}}
+<section class="volunteer">
+<h2>{{.Title}}</h2>
+{{- range $r.Volunteer}}
+<div class="entry">
+<div class="entry-head"><strong>{{.Role}}</strong><span>{{.DateRange}}</span></div>
+<div class="entry-sub"><span>{{.Organization}}</span><span>{{.Location}}</span></div>
+{{- if .Details}}
+<ul>
+{{- range .Details}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{- end}}
+</div>
+{{- end}}
+</section>
+{{- else if eq .Key "languages"}}
+<section class="languages">
+<h2>{{.Title}}</h2>
+<ul>
+{{- range $r.Languages}}
+<li><strong>{{.Name}}:</strong> {{languageText .}}</li>
+{{- end}}
+</ul>
+</section>
+{{- else if eq .Key "additional"}}
+{{- range $r.Additional}}
+<section class="additional">
+<h2>{{.Title}}</h2>
+<ul>
+{{- range .Items}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- end}}
+{{- end}}
+{{.Extra}}
+</body>
+</html>
+`))
+
+// GenerateHTML generates resume as a standalone HTML page in the given theme
+func (g *Generator) GenerateHTML(outputPath, theme string) error {
+	data, err := g.RenderHTML(theme, "")
+	if err != nil {
+		return err
+	}
+	return g.writeFile(outputPath, data, "HTML")
+}
+
+// RenderHTML renders the resume as HTML in the given theme. extra is inserted
+// verbatim before </body> and must be trusted markup.
+func (g *Generator) RenderHTML(theme, extra string) ([]byte, error) {
+	layout := g.layout()
+	if err := layout.Validate(); err != nil {
+		return nil, err
+	}
+
+	css, err := themeCSS(theme)
+	if err != nil {
+		return nil, err
+	}
+	photo, err := g.photoURL()
+	if err != nil {
+		return nil, err
+	}
+
+	view := htmlData{
+		Resume:   g.resume,
+		Lang:     g.locale,
+		CSS:      template.CSS(css),
+		Contacts: g.contacts(),
+		Photo:    photo,
+		Extra:    template.HTML(extra),
+	}
+	for _, key := range layout.Order() {
+		if !g.hasContent(key) {
+			continue
+		}
+		g.logger.Debug("rendering section", "format", "html", "section", key)
+		view.Sections = append(view.Sections, htmlSection{Key: key, Title: g.title(key)})
+	}
+
+	tmpl, err := htmlTemplate.Clone()
+	if err != nil {
+		return nil, err
+	}
+	tmpl.Funcs(template.FuncMap{
+		"label":        g.label,
+		"skillGroups":  g.skillGroups,
+		"skillLevel":   g.skillLevel,
+		"skillYears":   g.skillYears,
+		"skillsText":   g.skillsText,
+		"languageText": g.languageText,
+	})
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, view); err != nil {
+		return nil, fmt.Errorf("failed to render HTML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
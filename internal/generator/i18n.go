@@ -0,0 +1,123 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// labels holds the fixed strings used by the renderers, keyed by locale
+var labels = map[string]map[string]string{
+	"en": {
+		models.SectionSummary:        "Summary",
+		models.SectionEducation:      "Education",
+		models.SectionExperience:     "Experience",
+		models.SectionProjects:       "Projects",
+		models.SectionSkills:         "Skills",
+		models.SectionCertifications: "Certifications",
+		models.SectionAwards:         "Awards",
+		models.SectionPublications:   "Publications",
+		models.SectionVolunteer:      "Volunteer Experience",
+		models.SectionLanguages:      "Languages",
+		"relevant_courses":           "Relevant Courses",
+		"honors_awards":              "Honors & Awards",
+		"achievement":                "Achievement",
+		"credential_id":              "Credential ID",
+		"expires":                    "Expires %s",
+		"skill_languages":            "Languages",
+		"skill_frameworks":           "Frameworks",
+		"skill_databases":            "Databases",
+		"skill_tools":                "Tools",
+		"skill_other":                "Other",
+		"skill_year":                 "%s yr",
+		"skill_years":                "%s yrs",
+		"level_beginner":             "Beginner",
+		"level_elementary":           "Elementary",
+		"level_intermediate":         "Intermediate",
+		"level_advanced":             "Advanced",
+		"level_expert":               "Expert",
+		"language_native":            "Native",
+		"language_fluent":            "Fluent",
+		"language_conversational":    "Conversational",
+		"language_basic":             "Basic",
+		"letter_date_format":         "January 2, 2006",
+		"letter_greeting":            "Dear %s,",
+		"letter_greeting_default":    "Dear Hiring Manager,",
+		"letter_opening":             "I am writing to apply for the %s position at %s.",
+		"letter_highlights":          "Highlights from my experience include:",
+		"letter_role_at":             "%s, %s",
+		"letter_closing":             "Thank you for considering my application. I would welcome the opportunity to discuss how I can contribute to %s.",
+		"letter_sign_off":            "Sincerely,",
+	},
+	"zh": {
+		models.SectionSummary:        "个人简介",
+		models.SectionEducation:      "教育背景",
+		models.SectionExperience:     "工作经验",
+		models.SectionProjects:       "项目经验",
+		models.SectionSkills:         "专业技能",
+		models.SectionCertifications: "资格证书",
+		models.SectionAwards:         "获奖经历",
+		models.SectionPublications:   "论文发表",
+		models.SectionVolunteer:      "志愿经历",
+		models.SectionLanguages:      "语言能力",
+		"relevant_courses":           "相关课程",
+		"honors_awards":              "荣誉奖项",
+		"achievement":                "成果",
+		"credential_id":              "证书编号",
+		"expires":                    "有效期至 %s",
+		"skill_languages":            "编程语言",
+		"skill_frameworks":           "框架/库",
+		"skill_databases":            "数据库",
+		"skill_tools":                "工具",
+		"skill_other":                "其他",
+		"skill_year":                 "%s 年",
+		"skill_years":                "%s 年",
+		"level_beginner":             "入门",
+		"level_elementary":           "了解",
+		"level_intermediate":         "熟悉",
+		"level_advanced":             "熟练",
+		"level_expert":               "精通",
+		"language_native":            "母语",
+		"language_fluent":            "流利",
+		"language_conversational":    "日常交流",
+		"language_basic":             "基础",
+		"letter_date_format":         "2006年1月2日",
+		"letter_greeting":            "尊敬的%s：",
+		"letter_greeting_default":    "尊敬的招聘负责人：",
+		"letter_opening":             "我希望应聘%[2]s的%[1]s职位。",
+		"letter_highlights":          "以下是我过往工作中的几项亮点：",
+		"letter_role_at":             "%[2]s · %[1]s",
+		"letter_closing":             "感谢您审阅我的申请，期待有机会进一步沟通我能为%s带来的价值。",
+		"letter_sign_off":            "此致敬礼",
+	},
+}
+
+// label returns the localized string for key, falling back to English
+func (g *Generator) label(key string) string {
+	if value, ok := labels[g.locale][key]; ok {
+		return value
+	}
+	return labels["en"][key]
+}
+
+// title returns the heading for a section, honoring layout overrides
+func (g *Generator) title(key string) string {
+	return g.layout().Title(key, g.label(key))
+}
+
+// languageText describes a language level, localized when it is one of the
+// known levels, followed by any certification, e.g. "Fluent (IELTS 7.5)"
+func (g *Generator) languageText(lang models.Language) string {
+	level := lang.Level
+	if models.ValidLanguageLevel(level) {
+		level = g.label("language_" + strings.ToLower(strings.TrimSpace(level)))
+	}
+	switch {
+	case lang.Certification == "":
+		return level
+	case level == "":
+		return lang.Certification
+	}
+	return fmt.Sprintf("%s (%s)", level, lang.Certification)
+}
@@ -0,0 +1,335 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/pdf"
+	"gopkg.in/yaml.v3"
+)
+
+// maxHighlightBullets limits how many bullets each highlighted role contributes
+const maxHighlightBullets = 3
+
+// letterHighlight is a featured experience in a cover letter
+type letterHighlight struct {
+	Heading string   // Position and company
+	Bullets []string // Achievements, or responsibilities if there are none
+}
+
+// letterView is a cover letter with placeholders expanded and defaults filled in
+type letterView struct {
+	Letter     models.CoverLetter
+	Recipient  []string
+	Paragraphs []string // Opening and custom paragraphs, before the highlights
+	Highlights []letterHighlight
+}
+
+// letterData is what placeholders in cover letter text can refer to
+type letterData struct {
+	Personal      models.PersonalInfo
+	Company       string
+	Role          string
+	HiringManager string
+}
+
+// coverLetter resolves a letter against the resume
+func (g *Generator) coverLetter(letter *models.CoverLetter) (letterView, error) {
+	data := letterData{
+		Personal:      g.resume.PersonalInfo,
+		Company:       letter.Company,
+		Role:          letter.Role,
+		HiringManager: letter.HiringManager,
+	}
+	expand := func(field, text string) (string, error) {
+		tmpl, err := texttemplate.New(field).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return "", fmt.Errorf("cover letter %s: %w", field, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("cover letter %s: %w", field, err)
+		}
+		return strings.TrimSpace(buf.String()), nil
+	}
+
+	l := *letter
+	if l.Date == "" {
+		l.Date = time.Now().Format(g.label("letter_date_format"))
+	}
+	if l.Greeting == "" {
+		l.Greeting = g.label("letter_greeting_default")
+		if l.HiringManager != "" {
+			l.Greeting = fmt.Sprintf(g.label("letter_greeting"), l.HiringManager)
+		}
+	}
+	if l.Opening == "" {
+		l.Opening = fmt.Sprintf(g.label("letter_opening"), l.Role, l.Company)
+	}
+	if l.Closing == "" {
+		l.Closing = fmt.Sprintf(g.label("letter_closing"), l.Company)
+	}
+	if l.SignOff == "" {
+		l.SignOff = g.label("letter_sign_off")
+	}
+
+	var err error
+	fields := []struct {
+		name  string
+		value *string
+	}{
+		{"greeting", &l.Greeting},
+		{"opening", &l.Opening},
+		{"closing", &l.Closing},
+		{"sign_off", &l.SignOff},
+	}
+	for _, f := range fields {
+		if *f.value, err = expand(f.name, *f.value); err != nil {
+			return letterView{}, err
+		}
+	}
+	paragraphs := make([]string, len(l.Paragraphs))
+	for i, text := range l.Paragraphs {
+		if paragraphs[i], err = expand(fmt.Sprintf("paragraphs[%d]", i), text); err != nil {
+			return letterView{}, err
+		}
+	}
+	l.Paragraphs = paragraphs
+
+	view := letterView{Letter: l, Paragraphs: append([]string{l.Opening}, l.Paragraphs...)}
+	for _, line := range append([]string{l.HiringManager, l.Company}, l.CompanyAddress...) {
+		if line != "" {
+			view.Recipient = append(view.Recipient, line)
+		}
+	}
+	for _, ref := range l.Highlights {
+		exp, role, ok := g.findExperience(ref)
+		if !ok {
+			return letterView{}, fmt.Errorf("cover letter highlight %q matches no experience", ref)
+		}
+		bullets := role.Achievements
+		if len(bullets) == 0 {
+			bullets = role.Responsibilities
+		}
+		view.Highlights = append(view.Highlights, letterHighlight{
+			Heading: fmt.Sprintf(g.label("letter_role_at"), role.Position, exp.Company),
+			Bullets: bullets[:min(len(bullets), maxHighlightBullets)],
+		})
+	}
+	return view, nil
+}
+
+// findExperience returns the experience named by ref, either a company or
+// "company / position", compared case-insensitively, and the role it names.
+// A bare company stands for its most recent position and every bullet.
+func (g *Generator) findExperience(ref string) (models.Experience, models.Role, bool) {
+	company, position, hasPosition := strings.Cut(ref, "/")
+	company, position = strings.TrimSpace(company), strings.TrimSpace(position)
+	for _, exp := range g.resume.Experience {
+		if !strings.EqualFold(exp.Company, company) {
+			continue
+		}
+		if !hasPosition {
+			return exp, models.Role{
+				Position:         exp.Title(),
+				Responsibilities: exp.AllResponsibilities(),
+				Achievements:     exp.AllAchievements(),
+			}, true
+		}
+		for _, role := range exp.Positions() {
+			if strings.EqualFold(role.Position, position) {
+				return exp, role, true
+			}
+		}
+	}
+	return models.Experience{}, models.Role{}, false
+}
+
+// GenerateCoverLetter writes a cover letter in the given format and theme
+func (g *Generator) GenerateCoverLetter(outputPath string, letter *models.CoverLetter, format, theme string) error {
+	data, err := g.RenderCoverLetter(letter, format, theme)
+	if err != nil {
+		return err
+	}
+	return g.writeFile(outputPath, data, "cover letter")
+}
+
+// RenderCoverLetter renders a cover letter in the named format. The header and
+// theme match the resume so both documents look like a set.
+func (g *Generator) RenderCoverLetter(letter *models.CoverLetter, format, theme string) ([]byte, error) {
+	view, err := g.coverLetter(letter)
+	if err != nil {
+		return nil, err
+	}
+	g.logger.Debug("rendering cover letter", "format", format, "company", letter.Company, "highlights", len(view.Highlights))
+
+	switch format {
+	case "yaml":
+		data, err := yaml.Marshal(view.Letter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal cover letter to YAML: %w", err)
+		}
+		return data, nil
+	case "markdown", "md":
+		return []byte(g.letterMarkdown(view)), nil
+	case "html":
+		return g.letterHTML(view, theme)
+	case "pdf":
+		return g.letterPDF(view, theme)
+	default:
+		return nil, fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+func (g *Generator) letterMarkdown(view letterView) string {
+	var content strings.Builder
+	l := view.Letter
+	content.WriteString(g.markdownHeader())
+
+	content.WriteString(l.Date + "\n\n")
+	if len(view.Recipient) > 0 {
+		content.WriteString(strings.Join(view.Recipient, "  \n") + "\n\n")
+	}
+	content.WriteString(l.Greeting + "\n\n")
+	for _, paragraph := range view.Paragraphs {
+		content.WriteString(paragraph + "\n\n")
+	}
+	if len(view.Highlights) > 0 {
+		content.WriteString(g.label("letter_highlights") + "\n\n")
+		for _, h := range view.Highlights {
+			content.WriteString(fmt.Sprintf("**%s**\n", h.Heading))
+			for _, bullet := range h.Bullets {
+				content.WriteString(fmt.Sprintf("• %s\n", bullet))
+			}
+			content.WriteString("\n")
+		}
+	}
+	content.WriteString(l.Closing + "\n\n")
+	content.WriteString(l.SignOff + "  \n" + g.resume.PersonalInfo.Name + "\n")
+	return content.String()
+}
+
+// letterCSS lays out the letter body inside any theme
+const letterCSS = `
+.letter p { margin: 0 0 .9em; }
+.letter .date, .letter .recipient { margin-bottom: 1.2em; }
+.letter .signature { margin-top: 1.5em; }
+`
+
+var letterTemplate = template.Must(template.New("letter").Funcs(template.FuncMap{
+	"join": strings.Join,
+}).Parse(htmlPageHead + `{{template "page-head" .}}
+<main class="letter">
+<p class="date">{{.View.Letter.Date}}</p>
+{{- if .View.Recipient}}
+<p class="recipient">{{range $i, $line := .View.Recipient}}{{if $i}}<br>{{end}}{{$line}}{{end}}</p>
+{{- end}}
+<p>{{.View.Letter.Greeting}}</p>
+{{- range .View.Paragraphs}}
+<p>{{.}}</p>
+{{- end}}
+{{- if .View.Highlights}}
+<p>{{.HighlightsIntro}}</p>
+{{- range .View.Highlights}}
+<div class="entry">
+<strong>{{.Heading}}</strong>
+<ul>
+{{- range .Bullets}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+</div>
+{{- end}}
+{{- end}}
+<p>{{.View.Letter.Closing}}</p>
+<p class="signature">{{.View.Letter.SignOff}}<br>{{.Resume.PersonalInfo.Name}}</p>
+</main>
+</body>
+</html>
+`))
+
+func (g *Generator) letterHTML(view letterView, theme string) ([]byte, error) {
+	css, err := themeCSS(theme)
+	if err != nil {
+		return nil, err
+	}
+	data := struct {
+		Resume          *models.Resume
+		Lang            string
+		CSS             template.CSS
+		Contacts        []contact
+		Photo           template.URL // Letters go without the photo
+		View            letterView
+		HighlightsIntro string
+	}{g.resume, g.locale, template.CSS(css + letterCSS), g.contacts(), "", view, g.label("letter_highlights")}
+
+	var buf bytes.Buffer
+	if err := letterTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render HTML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *Generator) letterPDF(view letterView, themeName string) ([]byte, error) {
+	theme, ok := pdfThemes[themeName]
+	if themeName == "" {
+		theme, ok = pdfThemes[DefaultTheme], true
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q for PDF (available: %s)", themeName, strings.Join(Themes(), ", "))
+	}
+	width, height, err := pdf.PageSize(g.pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.New(width, height)
+	doc.Title = g.resume.PersonalInfo.Name
+	doc.Author = g.resume.PersonalInfo.Name
+	style := defaultPDFStyle
+	p := &pdfRenderer{doc: doc, style: style, theme: theme}
+	g.renderPDFHeader(p)
+
+	l := view.Letter
+	size := style.fontSize
+	paragraph := func(s string) {
+		p.text(s, size, false, theme.text, 0)
+		p.y += p.line() * 0.6
+	}
+
+	p.y += style.sectionGap
+	paragraph(l.Date)
+	for _, line := range view.Recipient {
+		p.text(line, size, false, theme.text, 0)
+	}
+	p.y += p.line() * 0.6
+	paragraph(l.Greeting)
+	for _, text := range view.Paragraphs {
+		paragraph(text)
+	}
+	if len(view.Highlights) > 0 {
+		paragraph(g.label("letter_highlights"))
+		for _, h := range view.Highlights {
+			p.text(h.Heading, size, true, theme.text, 0)
+			for _, bullet := range h.Bullets {
+				p.bullet(bullet)
+			}
+			p.y += style.entryGap
+		}
+	}
+	paragraph(l.Closing)
+	p.y += p.line()
+	p.text(l.SignOff, size, false, theme.text, 0)
+	p.text(g.resume.PersonalInfo.Name, size, true, theme.text, 0)
+
+	data, err := doc.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,586 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/pdf"
+)
+
+// Fit strategies, applied in the configured order until the content fits
+const (
+	FitSpacing  = "spacing"  // Tighten line height, gaps and margins
+	FitFont     = "font"     // Shrink the body font down to MinFontSize
+	FitPriority = "priority" // Drop entries marked priority: low, last first
+)
+
+// DefaultFitStrategies is the order used when none is configured
+var DefaultFitStrategies = []string{FitSpacing, FitFont, FitPriority}
+
+// PDFOptions configures PDF output
+type PDFOptions struct {
+	Theme       string
+	PageSize    string   // A4 or Letter
+	MaxPages    int      // Page limit; 0 disables fitting
+	MinFontSize float64  // Smallest body font size fitting may use; 0 means 9
+	Strategies  []string // Fit strategies in order; nil means DefaultFitStrategies
+}
+
+// FitReport describes the laid-out document and what fitting changed
+type FitReport struct {
+	Pages       int
+	Adjustments []string
+}
+
+// pdfStyle controls type size and spacing of PDF output
+type pdfStyle struct {
+	fontSize   float64 // Body text size in points
+	lineHeight float64 // Line advance as a multiple of the font size
+	sectionGap float64 // Space before each section heading
+	entryGap   float64 // Space between entries
+	margin     float64 // Page margin on all sides
+}
+
+var defaultPDFStyle = pdfStyle{fontSize: 10.5, lineHeight: 1.4, sectionGap: 14, entryGap: 8, margin: 50}
+
+// tighterSpacing are successive spacing steps tried by the spacing strategy
+var tighterSpacing = []pdfStyle{
+	{lineHeight: 1.3, sectionGap: 11, entryGap: 6, margin: 42},
+	{lineHeight: 1.2, sectionGap: 8, entryGap: 4, margin: 36},
+}
+
+// pdfTheme holds the colors of a theme as used in PDF output
+type pdfTheme struct {
+	text     pdf.Color
+	accent   pdf.Color
+	muted    pdf.Color
+	banner   bool // Draw the header on a filled accent band
+	centered bool // Center the name and contact line
+}
+
+var pdfThemes = map[string]pdfTheme{
+	"classic": {text: pdf.Color{R: .13, G: .13, B: .13}, accent: pdf.Color{R: .13, G: .13, B: .13}, muted: pdf.Color{R: .35, G: .35, B: .35}, centered: true},
+	"modern":  {text: pdf.Color{R: .12, G: .16, B: .2}, accent: pdf.Color{R: .12, G: .31, B: .47}, muted: pdf.Color{R: .32, G: .38, B: .43}, banner: true},
+	"minimal": {text: pdf.Color{R: .2, G: .2, B: .2}, accent: pdf.Color{R: .6, G: .6, B: .6}, muted: pdf.Color{R: .47, G: .47, B: .47}},
+}
+
+// GeneratePDF generates resume as a PDF, fitting it to opts.MaxPages if set
+func (g *Generator) GeneratePDF(outputPath string, opts PDFOptions) (FitReport, error) {
+	data, report, err := g.RenderPDF(opts)
+	if err != nil {
+		return report, err
+	}
+	return report, g.writeFile(outputPath, data, "PDF")
+}
+
+// RenderPDF renders the resume as PDF. When opts.MaxPages is set and the
+// content overflows, the fit strategies are applied in order until it fits;
+// an error is returned if it still does not.
+func (g *Generator) RenderPDF(opts PDFOptions) ([]byte, FitReport, error) {
+	if err := g.layout().Validate(); err != nil {
+		return nil, FitReport{}, err
+	}
+	theme, ok := pdfThemes[opts.Theme]
+	if opts.Theme == "" {
+		theme, ok = pdfThemes[DefaultTheme], true
+	}
+	if !ok {
+		return nil, FitReport{}, fmt.Errorf("unknown theme %q for PDF (available: %s)", opts.Theme, strings.Join(Themes(), ", "))
+	}
+	if opts.PageSize == "" {
+		opts.PageSize = g.pageSize
+	}
+	width, height, err := pdf.PageSize(opts.PageSize)
+	if err != nil {
+		return nil, FitReport{}, err
+	}
+
+	photo, err := g.pdfPhoto()
+	if err != nil {
+		return nil, FitReport{}, err
+	}
+
+	style := defaultPDFStyle
+	resume := g.resume
+	render := func() *pdf.Document {
+		doc := pdf.New(width, height)
+		doc.Title = resume.PersonalInfo.Name
+		doc.Author = resume.PersonalInfo.Name
+		sub := *g
+		sub.resume = resume
+		sub.renderPDF(doc, style, theme, photo)
+		return doc
+	}
+
+	doc := render()
+	report := FitReport{Pages: doc.PageCount()}
+	g.logger.Debug("laid out PDF", "pages", report.Pages, "font_size", style.fontSize)
+
+	if opts.MaxPages > 0 && report.Pages > opts.MaxPages {
+		fits := func() bool {
+			doc = render()
+			report.Pages = doc.PageCount()
+			g.logger.Debug("fit attempt", "pages", report.Pages, "font_size", style.fontSize, "line_height", style.lineHeight)
+			return report.Pages <= opts.MaxPages
+		}
+		adjust := func(format string, args ...any) {
+			report.Adjustments = append(report.Adjustments, fmt.Sprintf(format, args...))
+		}
+
+		strategies := opts.Strategies
+		if strategies == nil {
+			strategies = DefaultFitStrategies
+		}
+		minFont := opts.MinFontSize
+		if minFont <= 0 {
+			minFont = 9
+		}
+
+		done := false
+		for _, strategy := range strategies {
+			if done {
+				break
+			}
+			switch strategy {
+			case FitSpacing:
+				for _, step := range tighterSpacing {
+					style.lineHeight, style.sectionGap, style.entryGap, style.margin =
+						step.lineHeight, step.sectionGap, step.entryGap, step.margin
+					if done = fits(); done {
+						break
+					}
+				}
+				adjust("tightened spacing (line height %.2f, margins %.0fpt)", style.lineHeight, style.margin)
+			case FitFont:
+				start := style.fontSize
+				for style.fontSize-0.5 >= minFont && !done {
+					style.fontSize -= 0.5
+					done = fits()
+				}
+				if style.fontSize < start {
+					adjust("reduced font size from %.1fpt to %.1fpt", start, style.fontSize)
+				}
+			case FitPriority:
+				for !done {
+					trimmed, dropped := dropLowPriority(resume, g.layout().Order())
+					if dropped == "" {
+						break
+					}
+					resume = trimmed
+					adjust("dropped %s (priority: low)", dropped)
+					done = fits()
+				}
+			default:
+				return nil, report, fmt.Errorf("unknown fit strategy %q (valid: %s, %s, %s)", strategy, FitSpacing, FitFont, FitPriority)
+			}
+		}
+
+		if !done {
+			return nil, report, fmt.Errorf("content still needs %d pages after fitting (limit %d): %s",
+				report.Pages, opts.MaxPages, strings.Join(report.Adjustments, "; "))
+		}
+	}
+
+	data, err := doc.Bytes()
+	if err != nil {
+		return nil, report, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return data, report, nil
+}
+
+// dropLowPriority returns a copy of r without the last low-priority entry,
+// searching sections from the end of the layout, and a description of what
+// was dropped ("" if nothing was)
+func dropLowPriority(r *models.Resume, order []string) (*models.Resume, string) {
+	copied := *r
+	for i := len(order) - 1; i >= 0; i-- {
+		switch order[i] {
+		case models.SectionProjects:
+			for j := len(r.Projects) - 1; j >= 0; j-- {
+				if r.Projects[j].Priority == "low" {
+					copied.Projects = append(append([]models.Project{}, r.Projects[:j]...), r.Projects[j+1:]...)
+					return &copied, fmt.Sprintf("project %q", r.Projects[j].Name)
+				}
+			}
+		case models.SectionExperience:
+			for j := len(r.Experience) - 1; j >= 0; j-- {
+				if r.Experience[j].Priority == "low" {
+					copied.Experience = append(append([]models.Experience{}, r.Experience[:j]...), r.Experience[j+1:]...)
+					return &copied, fmt.Sprintf("experience %q", r.Experience[j].Company)
+				}
+			}
+		case models.SectionEducation:
+			for j := len(r.Education) - 1; j >= 0; j-- {
+				if r.Education[j].Priority == "low" {
+					copied.Education = append(append([]models.Education{}, r.Education[:j]...), r.Education[j+1:]...)
+					return &copied, fmt.Sprintf("education %q", r.Education[j].Institution)
+				}
+			}
+		}
+	}
+	return r, ""
+}
+
+// pdfRenderer lays out content top to bottom, starting new pages as needed
+type pdfRenderer struct {
+	doc     *pdf.Document
+	style   pdfStyle
+	theme   pdfTheme
+	y       float64
+	photo   *pdf.Image // Drawn in the header when set
+	reserve float64    // Width kept free at the right, e.g. beside the photo
+}
+
+func (p *pdfRenderer) line() float64         { return p.style.fontSize * p.style.lineHeight }
+func (p *pdfRenderer) contentWidth() float64 { return p.doc.Width - 2*p.style.margin - p.reserve }
+
+// ensure starts a new page unless h more points fit on the current one
+func (p *pdfRenderer) ensure(h float64) {
+	if p.doc.PageCount() == 0 || p.y+h > p.doc.Height-p.style.margin {
+		p.doc.AddPage()
+		p.y = p.style.margin
+	}
+}
+
+// text writes wrapped text starting at the given indent
+func (p *pdfRenderer) text(s string, size float64, bold bool, color pdf.Color, indent float64) {
+	for _, line := range pdf.Wrap(s, size, p.contentWidth()-indent) {
+		p.ensure(size * p.style.lineHeight)
+		p.y += size * p.style.lineHeight
+		p.doc.Text(p.style.margin+indent, p.y-size*0.25, size, bold, color, line)
+	}
+}
+
+// centered writes wrapped text centered on the page, clear of the reserved
+// width on both sides
+func (p *pdfRenderer) centered(s string, size float64, bold bool, color pdf.Color) {
+	for _, line := range pdf.Wrap(s, size, p.contentWidth()-p.reserve) {
+		p.ensure(size * p.style.lineHeight)
+		p.y += size * p.style.lineHeight
+		x := (p.doc.Width - pdf.TextWidth(line, size)) / 2
+		p.doc.Text(x, p.y-size*0.25, size, bold, color, line)
+	}
+}
+
+// row writes left text with right-aligned text on the same line
+func (p *pdfRenderer) row(left, right string, bold bool, color pdf.Color) {
+	size := p.style.fontSize
+	rightWidth := pdf.TextWidth(right, size)
+	lines := pdf.Wrap(left, size, p.contentWidth()-rightWidth-12)
+	for i, line := range lines {
+		p.ensure(p.line())
+		p.y += p.line()
+		p.doc.Text(p.style.margin, p.y-size*0.25, size, bold, color, line)
+		if i == 0 && right != "" {
+			p.doc.Text(p.doc.Width-p.style.margin-rightWidth, p.y-size*0.25, size, false, p.theme.muted, right)
+		}
+	}
+}
+
+// bullet writes a bulleted, wrapped item
+func (p *pdfRenderer) bullet(s string) {
+	size := p.style.fontSize
+	lines := pdf.Wrap(s, size, p.contentWidth()-14)
+	for i, line := range lines {
+		p.ensure(p.line())
+		p.y += p.line()
+		if i == 0 {
+			p.doc.Text(p.style.margin+3, p.y-size*0.25, size, false, p.theme.text, "•")
+		}
+		p.doc.Text(p.style.margin+14, p.y-size*0.25, size, false, p.theme.text, line)
+	}
+}
+
+// heading writes a section heading, keeping it on the same page as at least
+// two lines of the section body
+func (p *pdfRenderer) heading(title string) {
+	size := p.style.fontSize * 1.25
+	p.ensure(p.style.sectionGap + size*p.style.lineHeight + 2*p.line())
+	p.y += p.style.sectionGap
+	p.text(title, size, true, p.theme.accent, 0)
+	p.y += 2
+	p.doc.Line(p.style.margin, p.y, p.doc.Width-p.style.margin, p.y, 0.6, p.theme.accent)
+	p.y += 3
+}
+
+// renderPDF draws the whole resume into doc
+func (g *Generator) renderPDF(doc *pdf.Document, style pdfStyle, theme pdfTheme, photo *pdf.Image) {
+	p := &pdfRenderer{doc: doc, style: style, theme: theme, photo: photo}
+	g.renderPDFHeader(p)
+
+	for _, key := range g.layout().Order() {
+		if !g.hasContent(key) {
+			continue
+		}
+		g.logger.Debug("rendering section", "format", "pdf", "section", key)
+		g.renderPDFSection(p, key)
+	}
+}
+
+// photoHeight is the height of the header photo in points
+const photoHeight = 96
+
+// renderPDFHeader starts the first page with the name, title and contact line
+// in the theme's header style, shared by the resume and the cover letter.
+// The photo, if any, sits at the right and the header text wraps beside it.
+func (g *Generator) renderPDFHeader(p *pdfRenderer) {
+	r := g.resume
+	doc, style, theme := p.doc, p.style, p.theme
+	p.ensure(0)
+
+	var photoWidth float64
+	if p.photo != nil {
+		photoWidth = photoHeight * float64(p.photo.Width) / float64(p.photo.Height)
+		p.reserve = photoWidth + 12
+		defer func() { p.reserve = 0 }()
+	}
+	top := p.y
+	photo := func(inset float64) {
+		if p.photo != nil {
+			doc.Image(doc.Width-style.margin-inset-photoWidth, top+inset, photoWidth, photoHeight, p.photo)
+			p.y = max(p.y, top+photoHeight+2*inset)
+		}
+	}
+
+	contact := strings.Join(g.contactParts(), "  |  ")
+	title := r.PersonalInfo.Title
+	titleSize := style.fontSize * 1.2
+	if theme.banner {
+		nameSize := style.fontSize * 2.2
+		bandHeight := nameSize*1.4 + style.fontSize*style.lineHeight*float64(len(pdf.Wrap(contact, style.fontSize, p.contentWidth()-24))) + 20
+		if title != "" {
+			bandHeight += titleSize * style.lineHeight * float64(len(pdf.Wrap(title, titleSize, p.contentWidth()-24)))
+		}
+		if p.photo != nil {
+			bandHeight = max(bandHeight, photoHeight+16)
+		}
+		doc.Rect(style.margin, p.y, p.contentWidth()+p.reserve, bandHeight, theme.accent)
+		white := pdf.Color{R: 1, G: 1, B: 1}
+		p.y += 8
+		p.text(r.PersonalInfo.Name, nameSize, true, white, 12)
+		if title != "" {
+			p.text(title, titleSize, false, white, 12)
+		}
+		if contact != "" {
+			p.text(contact, style.fontSize, false, white, 12)
+		}
+		p.y += 12
+		photo(8)
+		p.y = max(p.y, top+bandHeight)
+	} else if theme.centered {
+		p.centered(r.PersonalInfo.Name, style.fontSize*2.2, true, theme.text)
+		if title != "" {
+			p.centered(title, titleSize, false, theme.text)
+		}
+		if contact != "" {
+			p.centered(contact, style.fontSize, false, theme.muted)
+		}
+		p.y += 4
+		photo(0)
+		doc.Line(style.margin, p.y, doc.Width-style.margin, p.y, 1.2, theme.accent)
+	} else {
+		p.text(r.PersonalInfo.Name, style.fontSize*2.2, false, theme.text, 0)
+		if title != "" {
+			p.text(title, titleSize, false, theme.text, 0)
+		}
+		if contact != "" {
+			p.text(contact, style.fontSize, false, theme.muted, 0)
+		}
+		photo(0)
+	}
+}
+
+// pdfBullets draws responsibilities followed by labelled achievements
+func (g *Generator) pdfBullets(p *pdfRenderer, responsibilities, achievements []string) {
+	for _, resp := range responsibilities {
+		p.bullet(resp)
+	}
+	for _, achievement := range achievements {
+		p.bullet(g.label("achievement") + ": " + achievement)
+	}
+}
+
+// renderPDFSkillMatrix draws each skill category as a table of skills with
+// a level bar and years of use
+func (g *Generator) renderPDFSkillMatrix(p *pdfRenderer, skills models.Skills) {
+	size := p.style.fontSize
+	nameWidth := p.contentWidth() * 0.4
+	const segment, gap = 12.0, 2.0
+	barX := p.style.margin + nameWidth
+	yearsX := barX + models.MaxSkillLevel*(segment+gap) + 8
+	for i, group := range g.skillGroups(skills) {
+		if i > 0 {
+			p.y += p.style.entryGap / 2
+		}
+		p.ensure(2 * p.line())
+		p.row(group.Name, "", true, p.theme.text)
+		for _, skill := range group.Skills {
+			p.ensure(p.line())
+			p.y += p.line()
+			baseline := p.y - size*0.25
+			p.doc.Text(p.style.margin+8, baseline, size, false, p.theme.text, skill.Name)
+			if rank := skill.Rank(); rank > 0 {
+				for step, reached := range levelSteps(rank) {
+					color := pdf.Color{R: .85, G: .85, B: .85}
+					if reached {
+						color = p.theme.accent
+					}
+					p.doc.Rect(barX+float64(step)*(segment+gap), baseline-size*0.6, segment, size*0.6, color)
+				}
+			}
+			if years := g.skillYears(skill); years != "" {
+				p.doc.Text(yearsX, baseline, size, false, p.theme.muted, years)
+			}
+		}
+	}
+}
+
+// renderPDFSection draws the section identified by key
+func (g *Generator) renderPDFSection(p *pdfRenderer, key string) {
+	r := g.resume
+	theme := p.theme
+
+	switch key {
+	case models.SectionSummary:
+		p.heading(g.title(key))
+		p.text(r.Summary, p.style.fontSize, false, theme.text, 0)
+
+	case models.SectionEducation:
+		p.heading(g.title(key))
+		for i, edu := range r.Education {
+			if i > 0 {
+				p.y += p.style.entryGap
+			}
+			degree := edu.Degree
+			if edu.Major != "" {
+				degree += " · " + edu.Major
+			}
+			p.row(degree, edu.DateRange(), true, theme.text)
+			p.row(edu.Institution, edu.Location, false, theme.muted)
+			if len(edu.RelevantCourses) > 0 {
+				p.bullet(g.label("relevant_courses") + ": " + strings.Join(edu.RelevantCourses, ", "))
+			}
+			if len(edu.HonorsAwards) > 0 {
+				p.bullet(g.label("honors_awards") + ": " + strings.Join(edu.HonorsAwards, ", "))
+			}
+		}
+
+	case models.SectionExperience:
+		p.heading(g.title(key))
+		for i, exp := range r.Experience {
+			if i > 0 {
+				p.y += p.style.entryGap
+			}
+			p.ensure(3 * p.line())
+			if exp.HasRoles() {
+				p.row(exp.Company, exp.DateRange(), true, theme.text)
+				if exp.Location != "" {
+					p.row(exp.Location, "", false, theme.muted)
+				}
+				g.pdfBullets(p, exp.Responsibilities, exp.Achievements)
+				for _, role := range exp.Roles {
+					p.ensure(2 * p.line())
+					p.row(role.Position, role.DateRange(), false, theme.accent)
+					g.pdfBullets(p, role.Responsibilities, role.Achievements)
+				}
+				continue
+			}
+			p.row(exp.Position, exp.DateRange(), true, theme.text)
+			p.row(exp.Company, exp.Location, false, theme.muted)
+			g.pdfBullets(p, exp.Responsibilities, exp.Achievements)
+		}
+
+	case models.SectionProjects:
+		p.heading(g.title(key))
+		for i, project := range r.Projects {
+			if i > 0 {
+				p.y += p.style.entryGap
+			}
+			p.ensure(3 * p.line())
+			p.row(project.Name, project.DateRange(), true, theme.text)
+			p.row(project.Description, project.Location, false, theme.muted)
+			for _, detail := range project.Details {
+				p.bullet(detail)
+			}
+		}
+
+	case models.SectionSkills:
+		p.heading(g.title(key))
+		if r.Skills.HasLevels() {
+			g.renderPDFSkillMatrix(p, r.Skills)
+			break
+		}
+		for _, group := range g.skillGroups(r.Skills) {
+			p.bullet(group.Name + ": " + g.skillsText(group.Skills))
+		}
+
+	case models.SectionCertifications:
+		p.heading(g.title(key))
+		for i, cert := range r.Certifications {
+			if i > 0 {
+				p.y += p.style.entryGap
+			}
+			p.row(cert.Name, cert.FormatDate(), true, theme.text)
+			expires := ""
+			if date := cert.FormatExpires(); date != "" {
+				expires = fmt.Sprintf(g.label("expires"), date)
+			}
+			if cert.Issuer != "" || expires != "" {
+				p.row(cert.Issuer, expires, false, theme.muted)
+			}
+			if cert.CredentialID != "" {
+				p.bullet(g.label("credential_id") + ": " + cert.CredentialID)
+			}
+		}
+
+	case models.SectionAwards:
+		p.heading(g.title(key))
+		for i, award := range r.Awards {
+			if i > 0 {
+				p.y += p.style.entryGap
+			}
+			p.row(award.Title, award.FormatDate(), true, theme.text)
+			if award.Issuer != "" {
+				p.row(award.Issuer, "", false, theme.muted)
+			}
+			if award.Description != "" {
+				p.text(award.Description, p.style.fontSize, false, theme.text, 0)
+			}
+		}
+
+	case models.SectionPublications:
+		p.heading(g.title(key))
+		for _, pub := range r.Publications {
+			p.bullet(pub.Citation())
+		}
+
+	case models.SectionVolunteer:
+		p.heading(g.title(key))
+		for i, v := range r.Volunteer {
+			if i > 0 {
+				p.y += p.style.entryGap
+			}
+			p.ensure(3 * p.line())
+			p.row(v.Role, v.DateRange(), true, theme.text)
+			p.row(v.Organization, v.Location, false, theme.muted)
+			for _, detail := range v.Details {
+				p.bullet(detail)
+			}
+		}
+
+	case models.SectionLanguages:
+		p.heading(g.title(key))
+		for _, lang := range r.Languages {
+			p.bullet(lang.Name + ": " + g.languageText(lang))
+		}
+
+	case models.SectionAdditional:
+		for _, section := range r.Additional {
+			p.heading(section.Title)
+			for _, item := range section.Items {
+				p.bullet(item)
+			}
+		}
+	}
+}
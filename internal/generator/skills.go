@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// skillGroup is a labelled skill category as rendered
+type skillGroup struct {
+	Name   string
+	Skills models.SkillList
+}
+
+// skillGroups returns the non-empty skill categories with their labels, the
+// fixed categories first
+func (g *Generator) skillGroups(s models.Skills) []skillGroup {
+	groups := []skillGroup{
+		{g.label("skill_languages"), s.Languages},
+		{g.label("skill_frameworks"), s.Frameworks},
+		{g.label("skill_databases"), s.Databases},
+		{g.label("skill_tools"), s.Tools},
+		{g.label("skill_other"), s.Other},
+	}
+	for _, custom := range s.Custom {
+		groups = append(groups, skillGroup{custom.Name, custom.Items})
+	}
+	var result []skillGroup
+	for _, group := range groups {
+		if len(group.Skills) > 0 {
+			result = append(result, group)
+		}
+	}
+	return result
+}
+
+// skillLevel returns the localized level of a skill, or ""
+func (g *Generator) skillLevel(skill models.Skill) string {
+	if name := skill.LevelName(); name != "" {
+		return g.label("level_" + name)
+	}
+	return ""
+}
+
+// skillYears formats years of use, e.g. "5 yrs", or ""
+func (g *Generator) skillYears(skill models.Skill) string {
+	if skill.Years <= 0 {
+		return ""
+	}
+	key := "skill_years"
+	if skill.Years == 1 {
+		key = "skill_year"
+	}
+	return fmt.Sprintf(g.label(key), strconv.FormatFloat(skill.Years, 'f', -1, 64))
+}
+
+// skillText is the text form of a skill, e.g. "Go (Expert, 5 yrs)", used by
+// outputs that cannot draw a level bar
+func (g *Generator) skillText(skill models.Skill) string {
+	var details []string
+	for _, detail := range []string{g.skillLevel(skill), g.skillYears(skill)} {
+		if detail != "" {
+			details = append(details, detail)
+		}
+	}
+	if len(details) == 0 {
+		return skill.Name
+	}
+	return fmt.Sprintf("%s (%s)", skill.Name, strings.Join(details, ", "))
+}
+
+// skillsText joins the text forms of a list of skills
+func (g *Generator) skillsText(skills models.SkillList) string {
+	texts := make([]string, len(skills))
+	for i, skill := range skills {
+		texts[i] = g.skillText(skill)
+	}
+	return strings.Join(texts, ", ")
+}
+
+// levelSteps reports for each step of the level scale whether it is reached
+func levelSteps(rank int) []bool {
+	steps := make([]bool, models.MaxSkillLevel)
+	for i := range steps {
+		steps[i] = i < rank
+	}
+	return steps
+}
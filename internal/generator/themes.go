@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// DefaultTheme is used when no theme is configured
+const DefaultTheme = "classic"
+
+// themes maps theme names to the stylesheet embedded in HTML output
+var themes = map[string]string{
+	"classic": `
+body { font-family: Georgia, "Times New Roman", "Songti SC", serif; color: #222; max-width: 800px; margin: 2em auto; padding: 0 1.5em; line-height: 1.45; }
+header { text-align: center; border-bottom: 2px solid #222; padding-bottom: .6em; margin-bottom: 1em; }
+header h1 { margin: 0; font-size: 2em; letter-spacing: .05em; }
+.contact { margin-top: .3em; font-size: .95em; }
+h2 { font-size: 1.15em; text-transform: uppercase; letter-spacing: .08em; border-bottom: 1px solid #999; padding-bottom: .15em; margin: 1.2em 0 .5em; }
+.entry { margin-bottom: .8em; }
+.entry-head, .entry-sub { display: flex; justify-content: space-between; gap: 1em; }
+.entry-sub { font-style: italic; }
+ul { margin: .3em 0; padding-left: 1.3em; }
+`,
+	"modern": `
+body { font-family: "Helvetica Neue", Arial, "PingFang SC", "Microsoft YaHei", sans-serif; color: #1f2933; max-width: 820px; margin: 2em auto; padding: 0 1.5em; line-height: 1.5; }
+header { background: #1f4e79; color: #fff; padding: 1.2em 1.5em; border-radius: 6px; margin-bottom: 1.2em; }
+header h1 { margin: 0; font-size: 2.1em; font-weight: 600; }
+.contact { margin-top: .4em; opacity: .9; }
+h2 { color: #1f4e79; font-size: 1.2em; margin: 1.3em 0 .5em; padding-left: .5em; border-left: 4px solid #1f4e79; }
+.entry { margin-bottom: .9em; }
+.entry-head, .entry-sub { display: flex; justify-content: space-between; gap: 1em; }
+.entry-head strong { color: #102a43; }
+.entry-sub { color: #52606d; }
+ul { margin: .3em 0; padding-left: 1.2em; }
+`,
+	"minimal": `
+body { font-family: system-ui, -apple-system, "Segoe UI", "Noto Sans SC", sans-serif; color: #333; max-width: 760px; margin: 2.5em auto; padding: 0 1.5em; line-height: 1.55; font-size: 15px; }
+header { margin-bottom: 1.5em; }
+header h1 { margin: 0; font-weight: 300; font-size: 2.2em; }
+.contact { color: #777; }
+h2 { font-weight: 400; font-size: 1em; color: #999; text-transform: uppercase; letter-spacing: .15em; margin: 1.6em 0 .6em; }
+.entry { margin-bottom: 1em; }
+.entry-head, .entry-sub { display: flex; justify-content: space-between; gap: 1em; }
+.entry-sub { color: #777; }
+ul { margin: .3em 0; padding-left: 1.1em; }
+`,
+}
+
+// headerCSS is appended to every theme, including theme files, to lay out the
+// optional title, links and photo in the header
+const headerCSS = `
+header .title { margin-top: .2em; font-size: 1.15em; }
+.contact a { color: inherit; }
+header .photo { float: right; width: 96px; max-height: 128px; object-fit: cover; border-radius: 4px; margin-left: 1em; }
+header::after { content: ""; display: block; clear: both; }
+`
+
+// rolesCSS is appended to every theme to lay out the successive roles listed
+// under one company
+const rolesCSS = `
+.role { margin-top: .4em; }
+.role-head { display: flex; justify-content: space-between; gap: 1em; font-style: italic; }
+`
+
+// skillsCSS is appended to every theme to draw the skill matrix used when
+// skills have levels
+const skillsCSS = `
+.skill-matrix h3 { font-size: 1em; margin: .6em 0 .2em; }
+.skill-matrix table { border-collapse: collapse; }
+.skill-matrix td { padding: .1em 1em .1em 0; }
+.skill-level span { display: inline-block; width: 1.2em; height: .55em; margin-right: 2px; background: #ddd; print-color-adjust: exact; -webkit-print-color-adjust: exact; }
+.skill-level span.on { background: currentColor; }
+.skill-years { color: #777; }
+`
+
+// printCSS is appended to every theme so browsers print a clean page
+const printCSS = `
+@media print { body { margin: 0 auto; } a { color: inherit; text-decoration: none; } }
+`
+
+// Themes returns the available theme names in sorted order
+func Themes() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ThemeFile returns the stylesheet path when the theme refers to a custom
+// CSS file rather than a built-in theme, and "" otherwise
+func ThemeFile(name string) string {
+	if strings.HasSuffix(strings.ToLower(name), ".css") {
+		return name
+	}
+	return ""
+}
+
+// themeCSS returns the stylesheet for a theme, reading custom CSS files from disk
+func themeCSS(name string) (string, error) {
+	if name == "" {
+		name = DefaultTheme
+	}
+	if path := ThemeFile(name); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read theme file: %w", err)
+		}
+		return string(data) + headerCSS + rolesCSS + skillsCSS + printCSS, nil
+	}
+	css, ok := themes[name]
+	if !ok {
+		return "", fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(Themes(), ", "))
+	}
+	return css + headerCSS + rolesCSS + skillsCSS + printCSS, nil
+}
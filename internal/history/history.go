@@ -0,0 +1,164 @@
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Snapshot describes one stored copy of a resume
+type Snapshot struct {
+	ID      string    `json:"id"`
+	Created time.Time `json:"created"`
+	Message string    `json:"message,omitempty"`
+	Source  string    `json:"source"` // Absolute path the resume was read from
+	SHA256  string    `json:"sha256"` // Checksum of the stored content
+}
+
+// Store keeps snapshots as files in a directory: <id>.yaml holds the resume
+// and <id>.json its metadata
+type Store struct {
+	Dir string
+}
+
+// Open returns the snapshot store inside a data directory
+func Open(dataDir string) *Store {
+	return &Store{Dir: filepath.Join(dataDir, "snapshots")}
+}
+
+// Save stores data as a new snapshot. IDs are the creation time followed by
+// a short content hash, so they sort chronologically. The source is stored
+// as an absolute path so restore works from any directory.
+func (s *Store) Save(source string, data []byte, message string, now time.Time) (Snapshot, error) {
+	if abs, err := filepath.Abs(source); err == nil {
+		source = abs
+	}
+	checksum := Checksum(data)
+	snap := Snapshot{
+		ID:      now.Format("20060102-150405") + "-" + checksum[:7],
+		Created: now,
+		Message: message,
+		Source:  source,
+		SHA256:  checksum,
+	}
+
+	if err := os.MkdirAll(s.Dir, 0755); err != nil {
+		return snap, fmt.Errorf("failed to create snapshot store: %w", err)
+	}
+	// The same content saved twice within a second would get the same ID
+	base := snap.ID
+	for n := 2; ; n++ {
+		if _, err := os.Stat(s.path(snap.ID, ".json")); errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		snap.ID = fmt.Sprintf("%s-%d", base, n)
+	}
+	if err := os.WriteFile(s.path(snap.ID, ".yaml"), data, 0644); err != nil {
+		return snap, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	meta, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return snap, err
+	}
+	if err := os.WriteFile(s.path(snap.ID, ".json"), append(meta, '\n'), 0644); err != nil {
+		return snap, fmt.Errorf("failed to write snapshot metadata: %w", err)
+	}
+	return snap, nil
+}
+
+// List returns all snapshots, newest first
+func (s *Store) List() ([]Snapshot, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot store: %w", err)
+	}
+
+	var snapshots []Snapshot
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.Dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var snap Snapshot
+		if err := json.Unmarshal(data, &snap); err != nil {
+			return nil, fmt.Errorf("corrupt snapshot metadata %s: %w", entry.Name(), err)
+		}
+		snapshots = append(snapshots, snap)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].ID > snapshots[j].ID })
+	return snapshots, nil
+}
+
+// Find returns the snapshot whose ID equals ref or uniquely starts with it.
+// "latest" refers to the newest snapshot.
+func (s *Store) Find(ref string) (Snapshot, error) {
+	snapshots, err := s.List()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if ref == "latest" && len(snapshots) > 0 {
+		return snapshots[0], nil
+	}
+
+	var matches []Snapshot
+	for _, snap := range snapshots {
+		if snap.ID == ref {
+			return snap, nil
+		}
+		if strings.HasPrefix(snap.ID, ref) {
+			matches = append(matches, snap)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return Snapshot{}, fmt.Errorf("no snapshot matches %q", ref)
+	case 1:
+		return matches[0], nil
+	}
+	return Snapshot{}, fmt.Errorf("%q matches %d snapshots; use a longer ID", ref, len(matches))
+}
+
+// Read returns the stored resume of a snapshot, verifying its checksum
+func (s *Store) Read(snap Snapshot) ([]byte, error) {
+	data, err := os.ReadFile(s.path(snap.ID, ".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", snap.ID, err)
+	}
+	if Checksum(data) != snap.SHA256 {
+		return nil, fmt.Errorf("snapshot %s was modified after it was taken", snap.ID)
+	}
+	return data, nil
+}
+
+// Delete removes a snapshot and its metadata
+func (s *Store) Delete(snap Snapshot) error {
+	for _, ext := range []string{".yaml", ".json"} {
+		if err := os.Remove(s.path(snap.ID, ext)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to delete snapshot %s: %w", snap.ID, err)
+		}
+	}
+	return nil
+}
+
+// Checksum returns the checksum Save would record for data
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Store) path(id, ext string) string {
+	return filepath.Join(s.Dir, id+ext)
+}
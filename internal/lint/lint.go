@@ -0,0 +1,130 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// Severity ranks how serious an issue is
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// rank orders severities from least to most serious
+func (s Severity) rank() int {
+	switch s {
+	case SeverityError:
+		return 2
+	case SeverityWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// AtLeast reports whether s is as serious as other
+func (s Severity) AtLeast(other Severity) bool {
+	return s.rank() >= other.rank()
+}
+
+// Issue is a single finding reported by a rule
+type Issue struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Location string   `json:"location"`
+	Message  string   `json:"message"`
+	Text     string   `json:"text,omitempty"`
+
+	position int // Document order of the checked text, used for sorting
+}
+
+// Rule checks one aspect of resume content
+type Rule interface {
+	Name() string
+	Description() string
+	DefaultSeverity() Severity
+	Check(r *models.Resume, cfg RuleConfig) []Issue
+}
+
+// Config configures the linter, typically from the "lint" key of .resugo.yaml
+type Config struct {
+	Rules map[string]RuleConfig `yaml:"rules,omitempty"`
+}
+
+// RuleConfig holds per-rule settings. Unset fields keep the rule's defaults.
+type RuleConfig struct {
+	Enabled  *bool    `yaml:"enabled,omitempty"`
+	Severity Severity `yaml:"severity,omitempty"`
+	MaxWords int      `yaml:"max_words,omitempty"` // long-bullet: English word limit
+	MaxChars int      `yaml:"max_chars,omitempty"` // long-bullet: CJK character limit
+	Words    []string `yaml:"words,omitempty"`     // Extra phrases for word-list rules
+}
+
+// Rules returns every built-in rule
+func Rules() []Rule {
+	return []Rule{
+		weakOpenerRule{},
+		missingNumberRule{},
+		firstPersonRule{},
+		longBulletRule{},
+		tenseRule{},
+		duplicateBulletRule{},
+	}
+}
+
+// Validate checks that the config only refers to known rules and severities
+func (c Config) Validate() error {
+	known := make(map[string]bool)
+	for _, rule := range Rules() {
+		known[rule.Name()] = true
+	}
+	for name, rc := range c.Rules {
+		if !known[name] {
+			return fmt.Errorf("lint: unknown rule %q", name)
+		}
+		switch rc.Severity {
+		case "", SeverityInfo, SeverityWarning, SeverityError:
+		default:
+			return fmt.Errorf("lint: rule %s: unknown severity %q", name, rc.Severity)
+		}
+	}
+	return nil
+}
+
+// Run checks the resume with every enabled rule and returns issues in
+// document order. Rules named in disabled are skipped regardless of config.
+func Run(r *models.Resume, cfg Config, disabled ...string) []Issue {
+	skip := make(map[string]bool)
+	for _, name := range disabled {
+		skip[name] = true
+	}
+
+	var issues []Issue
+	for _, rule := range Rules() {
+		rc := cfg.Rules[rule.Name()]
+		if skip[rule.Name()] || (rc.Enabled != nil && !*rc.Enabled) {
+			continue
+		}
+
+		severity := rule.DefaultSeverity()
+		if rc.Severity != "" {
+			severity = rc.Severity
+		}
+		for _, issue := range rule.Check(r, rc) {
+			issue.Rule = rule.Name()
+			issue.Severity = severity
+			issues = append(issues, issue)
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].position < issues[j].position
+	})
+	return issues
+}
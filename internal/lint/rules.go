@@ -0,0 +1,303 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/textutil"
+)
+
+// weakOpenerRule flags bullets that open with a passive phrase instead of an action verb
+type weakOpenerRule struct{}
+
+var weakOpeners = []string{
+	"responsible for", "worked on", "helped", "assisted", "participated in",
+	"involved in", "duties included", "tasked with", "in charge of",
+	"主要负责", "负责", "参与", "协助", "帮助", "配合",
+}
+
+func (weakOpenerRule) Name() string              { return "weak-opener" }
+func (weakOpenerRule) DefaultSeverity() Severity { return SeverityWarning }
+func (weakOpenerRule) Description() string {
+	return "Bullets should start with a strong action verb, not \"Responsible for\" or \"负责\""
+}
+
+func (weakOpenerRule) Check(r *models.Resume, cfg RuleConfig) []Issue {
+	phrases := append(append([]string{}, cfg.Words...), weakOpeners...)
+
+	var issues []Issue
+	for _, b := range collectBullets(r) {
+		text := strings.ToLower(strings.TrimSpace(b.text))
+		for _, phrase := range phrases {
+			phrase = strings.ToLower(phrase)
+			if !strings.HasPrefix(text, phrase) {
+				continue
+			}
+			// English phrases must end on a word boundary ("helped" but not "helpedesk")
+			next, _ := utf8.DecodeRuneInString(text[len(phrase):])
+			if textutil.CJKCount(phrase) == 0 && (unicode.IsLetter(next) || unicode.IsDigit(next)) {
+				continue
+			}
+			// Followed by 人 the verb is part of a noun: "负责人" is the person in charge
+			if textutil.CJKCount(phrase) > 0 && next == '人' {
+				continue
+			}
+			issues = append(issues, Issue{
+				Location: b.location,
+				position: b.position,
+				Message:  fmt.Sprintf("starts with weak phrase %q; lead with what you achieved", phrase),
+				Text:     truncate(b.text, 60),
+			})
+			break
+		}
+	}
+	return issues
+}
+
+// missingNumberRule flags responsibilities and achievements without any quantity
+type missingNumberRule struct{}
+
+func (missingNumberRule) Name() string              { return "missing-number" }
+func (missingNumberRule) DefaultSeverity() Severity { return SeverityInfo }
+func (missingNumberRule) Description() string {
+	return "Responsibilities and achievements should be quantified with numbers"
+}
+
+func (missingNumberRule) Check(r *models.Resume, cfg RuleConfig) []Issue {
+	var issues []Issue
+	for _, b := range collectBullets(r) {
+		if b.kind == "detail" || hasNumber(b.text) {
+			continue
+		}
+		issues = append(issues, Issue{
+			Location: b.location,
+			position: b.position,
+			Message:  "no numbers; quantify scale, impact or results",
+			Text:     truncate(b.text, 60),
+		})
+	}
+	return issues
+}
+
+// firstPersonRule flags first-person pronouns in the summary and bullets
+type firstPersonRule struct{}
+
+var (
+	englishPronouns = map[string]bool{"i": true, "me": true, "my": true, "mine": true, "myself": true, "we": true, "our": true, "us": true}
+	chinesePronouns = []string{"我们", "本人", "我"}
+	// notPronouns are words that contain 我 without referring to the writer
+	notPronouns = strings.NewReplacer("自我", " ", "忘我", " ", "无我", " ", "本我", " ", "超我", " ", "敌我", " ")
+)
+
+func (firstPersonRule) Name() string              { return "first-person" }
+func (firstPersonRule) DefaultSeverity() Severity { return SeverityWarning }
+func (firstPersonRule) Description() string {
+	return "Resumes are written without first-person pronouns (I, my, 我, 本人)"
+}
+
+func (firstPersonRule) Check(r *models.Resume, cfg RuleConfig) []Issue {
+	texts := []bullet{{text: r.Summary, location: "summary"}}
+	texts = append(texts, collectBullets(r)...)
+
+	var issues []Issue
+	for _, b := range texts {
+		if pronoun := findPronoun(b.text, cfg.Words); pronoun != "" {
+			issues = append(issues, Issue{
+				Location: b.location,
+				position: b.position,
+				Message:  fmt.Sprintf("uses first-person pronoun %q", pronoun),
+				Text:     truncate(b.text, 60),
+			})
+		}
+	}
+	return issues
+}
+
+func findPronoun(text string, extra []string) string {
+	for _, word := range textutil.LatinWords(text) {
+		lower := strings.ToLower(word)
+		if englishPronouns[lower] {
+			// "US" in capitals is usually the country
+			if lower == "us" && word == "US" {
+				continue
+			}
+			return word
+		}
+	}
+	text = notPronouns.Replace(text)
+	for _, pronoun := range append(append([]string{}, extra...), chinesePronouns...) {
+		if strings.Contains(text, pronoun) {
+			return pronoun
+		}
+	}
+	return ""
+}
+
+// longBulletRule flags bullets that are too long to skim
+type longBulletRule struct{}
+
+func (longBulletRule) Name() string              { return "long-bullet" }
+func (longBulletRule) DefaultSeverity() Severity { return SeverityWarning }
+func (longBulletRule) Description() string {
+	return "Bullets should fit on one or two lines (default: 30 English words or 60 Chinese characters)"
+}
+
+func (longBulletRule) Check(r *models.Resume, cfg RuleConfig) []Issue {
+	maxWords, maxChars := cfg.MaxWords, cfg.MaxChars
+	if maxWords <= 0 {
+		maxWords = 30
+	}
+	if maxChars <= 0 {
+		maxChars = 60
+	}
+
+	var issues []Issue
+	for _, b := range collectBullets(r) {
+		words, chars := len(textutil.LatinWords(b.text)), textutil.CJKCount(b.text)
+		var message string
+		switch {
+		case chars > 0 && chars+words > maxChars:
+			message = fmt.Sprintf("%d characters (limit %d)", chars+words, maxChars)
+		case chars == 0 && words > maxWords:
+			message = fmt.Sprintf("%d words (limit %d)", words, maxWords)
+		default:
+			continue
+		}
+		issues = append(issues, Issue{
+			Location: b.location,
+			position: b.position,
+			Message:  "too long: " + message,
+			Text:     truncate(b.text, 60),
+		})
+	}
+	return issues
+}
+
+// tenseRule flags bullets whose tense does not match the role: present for
+// current roles, past for finished ones
+type tenseRule struct{}
+
+var (
+	// presentVerbs are common resume action verbs in their base form
+	presentVerbs = []string{
+		"analyze", "architect", "automate", "build", "collaborate", "coordinate",
+		"create", "define", "deliver", "deploy", "design", "develop", "drive",
+		"establish", "implement", "improve", "increase", "launch", "lead",
+		"maintain", "manage", "mentor", "migrate", "optimize", "own", "reduce",
+		"research", "run", "support", "test", "write",
+	}
+	// irregularPast are past forms that do not end in "-ed"
+	irregularPast = map[string]bool{
+		"built": true, "led": true, "ran": true, "wrote": true, "drove": true,
+		"made": true, "grew": true, "won": true, "took": true, "gave": true,
+		"cut": true, "set": true, "taught": true, "brought": true, "sold": true,
+		"began": true, "spent": true, "found": true, "held": true, "kept": true,
+	}
+	// ongoingMarkers indicate present tense in Chinese, which has no verb inflection
+	ongoingMarkers = []string{"目前", "正在", "至今"}
+)
+
+func (tenseRule) Name() string              { return "tense" }
+func (tenseRule) DefaultSeverity() Severity { return SeverityInfo }
+func (tenseRule) Description() string {
+	return "Current roles and projects use present tense, past ones use past tense"
+}
+
+func (tenseRule) Check(r *models.Resume, cfg RuleConfig) []Issue {
+	var issues []Issue
+	for _, b := range collectBullets(r) {
+		// Achievements describe finished results and read naturally in the past tense
+		if b.kind == "achievement" {
+			continue
+		}
+
+		entry := "role"
+		if b.kind == "detail" {
+			entry = "project"
+		}
+		var message string
+		switch tense := openingTense(b.text); {
+		case b.current && tense == "past":
+			message = "current " + entry + " written in past tense"
+		case !b.current && tense == "present":
+			message = "past " + entry + " written in present tense"
+		case !b.current && containsAny(b.text, ongoingMarkers):
+			message = "past " + entry + " described as ongoing"
+		default:
+			continue
+		}
+		issues = append(issues, Issue{
+			Location: b.location,
+			position: b.position,
+			Message:  message,
+			Text:     truncate(b.text, 60),
+		})
+	}
+	return issues
+}
+
+// openingTense classifies the first English word as "past", "present" or ""
+func openingTense(text string) string {
+	// Chinese verbs are not inflected, so only English openers are classified
+	if first, _ := utf8.DecodeRuneInString(strings.TrimSpace(text)); textutil.IsCJK(first) {
+		return ""
+	}
+	words := textutil.LatinWords(text)
+	if len(words) == 0 {
+		return ""
+	}
+	first := strings.ToLower(words[0])
+	if irregularPast[first] || (strings.HasSuffix(first, "ed") && len(first) > 4) {
+		return "past"
+	}
+	for _, verb := range presentVerbs {
+		if first == verb || first == verb+"s" || first == verb+"es" {
+			return "present"
+		}
+	}
+	return ""
+}
+
+func containsAny(text string, markers []string) bool {
+	for _, marker := range markers {
+		if strings.Contains(text, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// duplicateBulletRule flags bullets that appear more than once
+type duplicateBulletRule struct{}
+
+func (duplicateBulletRule) Name() string              { return "duplicate-bullet" }
+func (duplicateBulletRule) DefaultSeverity() Severity { return SeverityWarning }
+func (duplicateBulletRule) Description() string {
+	return "The same bullet should not appear twice"
+}
+
+func (duplicateBulletRule) Check(r *models.Resume, cfg RuleConfig) []Issue {
+	first := make(map[string]string)
+
+	var issues []Issue
+	for _, b := range collectBullets(r) {
+		key := normalize(b.text)
+		if key == "" {
+			continue
+		}
+		if location, seen := first[key]; seen {
+			issues = append(issues, Issue{
+				Location: b.location,
+				position: b.position,
+				Message:  "duplicates " + location,
+				Text:     truncate(b.text, 60),
+			})
+			continue
+		}
+		first[key] = b.location
+	}
+	return issues
+}
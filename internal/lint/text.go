@@ -0,0 +1,88 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// bullet is one line of free text together with where it came from
+type bullet struct {
+	text     string
+	location string
+	current  bool   // Belongs to an ongoing role or project
+	kind     string // "responsibility", "achievement" or "detail"
+	position int    // Document order; the summary is 0
+}
+
+// collectBullets returns every bullet point of the resume in document order
+func collectBullets(r *models.Resume) []bullet {
+	var bullets []bullet
+	for i, exp := range r.Experience {
+		if exp.HasRoles() {
+			// Company-wide bullets above the roles
+			prefix := fmt.Sprintf("experience[%d] %s", i, exp.Company)
+			_, _, current := exp.Tenure()
+			for j, text := range exp.Responsibilities {
+				bullets = append(bullets, bullet{text: text, location: fmt.Sprintf("%s: responsibility %d", prefix, j+1), current: current, kind: "responsibility"})
+			}
+			for j, text := range exp.Achievements {
+				bullets = append(bullets, bullet{text: text, location: fmt.Sprintf("%s: achievement %d", prefix, j+1), current: current, kind: "achievement"})
+			}
+		}
+		for k, role := range exp.Positions() {
+			prefix := fmt.Sprintf("experience[%d] %s", i, exp.Company)
+			if exp.HasRoles() {
+				prefix = fmt.Sprintf("experience[%d].roles[%d] %s / %s", i, k, exp.Company, role.Position)
+			}
+			for j, text := range role.Responsibilities {
+				bullets = append(bullets, bullet{text: text, location: fmt.Sprintf("%s: responsibility %d", prefix, j+1), current: role.Current, kind: "responsibility"})
+			}
+			for j, text := range role.Achievements {
+				bullets = append(bullets, bullet{text: text, location: fmt.Sprintf("%s: achievement %d", prefix, j+1), current: role.Current, kind: "achievement"})
+			}
+		}
+	}
+	for i, proj := range r.Projects {
+		prefix := fmt.Sprintf("projects[%d] %s", i, proj.Name)
+		for j, text := range proj.Details {
+			bullets = append(bullets, bullet{text: text, location: fmt.Sprintf("%s: detail %d", prefix, j+1), current: proj.Current, kind: "detail"})
+		}
+	}
+	for i := range bullets {
+		bullets[i].position = i + 1
+	}
+	return bullets
+}
+
+// hasNumber reports whether s contains an Arabic or Chinese numeral or a percentage
+func hasNumber(s string) bool {
+	for _, r := range s {
+		if unicode.IsDigit(r) || r == '%' || r == '％' || strings.ContainsRune("一二两三四五六七八九十百千万亿倍", r) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize lowercases s and drops spaces and punctuation for duplicate detection
+func normalize(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// truncate shortens s to n runes for display
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,94 @@
+package match
+
+// skillTerms are technologies and competencies recognized as skills in job
+// postings. Multi-word and Chinese terms are matched as whole phrases.
+var skillTerms = []string{
+	// Languages
+	"go", "golang", "java", "python", "javascript", "typescript", "c", "c++", "c#", "rust",
+	"kotlin", "swift", "scala", "ruby", "php", "sql", "bash", "shell", "lua", "r",
+	"html", "html5", "css", "css3", "objective-c", "dart", "elixir", "haskell",
+	// Frameworks and libraries
+	"react", "vue.js", "angular", "node.js", "express", "next.js", "django", "flask",
+	"fastapi", "spring", "spring boot", "gin", "echo", "grpc", "graphql", "rest",
+	"restful", "redux", "jquery", "bootstrap", "tailwind", "pytorch", "tensorflow",
+	"pandas", "numpy", "spark", "hadoop", "flink", "kafka", "rabbitmq", "celery",
+	"react native", "flutter", ".net", "rails",
+	// Data stores
+	"mysql", "postgresql", "mongodb", "redis", "elasticsearch", "cassandra", "sqlite",
+	"oracle", "dynamodb", "clickhouse", "etcd", "tidb", "hbase", "memcached",
+	// Infrastructure and tools
+	"docker", "kubernetes", "helm", "terraform", "ansible", "jenkins", "git",
+	"linux", "aws", "gcp", "azure", "nginx", "prometheus", "grafana", "ci/cd",
+	"github actions", "gitlab", "istio", "envoy", "serverless", "lambda", "jira",
+	// Practices and domains
+	"microservices", "distributed systems", "machine learning", "deep learning",
+	"data analysis", "system design", "unit testing", "test automation", "devops",
+	"agile", "scrum", "tdd", "observability", "high availability", "high concurrency",
+	"cloud native", "big data", "nlp", "computer vision", "security", "oauth",
+	"performance tuning", "data structures", "algorithms", "message queue",
+	// Chinese terms
+	"微服务", "分布式", "分布式系统", "高并发", "高可用", "高性能", "云原生", "容器化",
+	"机器学习", "深度学习", "数据分析", "数据挖掘", "大数据", "自然语言处理", "计算机视觉",
+	"系统设计", "架构设计", "性能优化", "性能调优", "单元测试", "自动化测试", "持续集成",
+	"消息队列", "缓存", "数据库", "数据结构", "算法", "网络编程", "并发编程", "多线程",
+	"前端", "后端", "全栈", "运维", "敏捷开发", "中间件", "搜索引擎", "推荐系统",
+	"操作系统", "计算机网络", "设计模式", "源码", "调优", "监控", "安全",
+}
+
+// aliases map alternative spellings to a canonical term
+var aliases = map[string]string{
+	"golang":       "go",
+	"k8s":          "kubernetes",
+	"postgres":     "postgresql",
+	"js":           "javascript",
+	"ts":           "typescript",
+	"nodejs":       "node.js",
+	"node":         "node.js",
+	"vue":          "vue.js",
+	"vuejs":        "vue.js",
+	"reactjs":      "react",
+	"react.js":     "react",
+	"nextjs":       "next.js",
+	"springboot":   "spring boot",
+	"mongo":        "mongodb",
+	"cicd":         "ci/cd",
+	"microservice": "microservices",
+	"html5":        "html",
+	"css3":         "css",
+	"restful":      "rest",
+	"分布式系统":        "分布式",
+	"性能调优":         "性能优化",
+	"调优":           "性能优化",
+	"spring-boot":  "spring boot",
+}
+
+// stopwords are common English words and job posting boilerplate that are
+// never keywords on their own
+var stopwords = toSet(
+	"a", "about", "above", "across", "after", "all", "also", "an", "and", "any", "are", "as", "at",
+	"be", "been", "being", "both", "but", "by", "can", "could", "do", "does", "each", "either",
+	"etc", "for", "from", "has", "have", "how", "if", "in", "including", "into", "is", "it",
+	"its", "just", "may", "more", "most", "must", "new", "no", "not", "of", "on", "one", "or",
+	"other", "our", "out", "over", "own", "plus", "preferred", "required", "same", "should",
+	"so", "some", "such", "than", "that", "the", "their", "them", "then", "there", "these",
+	"they", "this", "those", "through", "to", "under", "up", "us", "use", "used", "using",
+	"very", "via", "was", "we", "well", "were", "what", "when", "where", "which", "while",
+	"who", "whom", "why", "will", "with", "within", "would", "you", "your", "yours",
+	// Job posting boilerplate
+	"ability", "able", "apply", "applicant", "applicants", "background", "benefits",
+	"bonus", "candidate", "candidates", "company", "degree", "environment", "equal",
+	"excellent", "experience", "experienced", "familiar", "familiarity", "good", "great",
+	"help", "ideal", "job", "join", "knowledge", "looking", "opportunity", "position",
+	"proficiency", "proficient", "qualifications", "related", "requirements", "responsibilities",
+	"role", "salary", "skill", "skills", "strong", "team", "teams", "understanding", "work",
+	"working", "year", "years", "including", "nice", "have", "like", "make", "part",
+	"based", "across", "build", "building", "develop", "developing", "ensure", "etc.",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
@@ -0,0 +1,216 @@
+package match
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/textutil"
+)
+
+// Weights of a keyword in the coverage score
+const (
+	skillWeight   = 3.0
+	keywordWeight = 1.0
+)
+
+// caseSensitive terms are also ordinary English words and only count as
+// skills when capitalized, e.g. "Go" or "REST" but not "go" or "rest"
+var caseSensitive = toSet("go", "r", "c", "rest", "echo", "spring", "swift", "lambda", "express", "oracle", "shell", "rust", "dart", "security", "agile")
+
+// Keyword is a salient term from the job posting and where the resume covers it
+type Keyword struct {
+	Term         string  `json:"term"`
+	Count        int     `json:"count"`
+	Skill        bool    `json:"skill"`
+	Weight       float64 `json:"weight"`
+	InSkills     bool    `json:"in_skills"`
+	InExperience bool    `json:"in_experience"`
+	InProjects   bool    `json:"in_projects"`
+}
+
+// Matched reports whether the keyword appears anywhere in the resume
+func (k Keyword) Matched() bool {
+	return k.InSkills || k.InExperience || k.InProjects
+}
+
+// Report is the result of matching a resume against a job posting
+type Report struct {
+	Keywords []Keyword `json:"keywords"`
+	Coverage float64   `json:"coverage"` // Weighted share of matched keywords, 0 to 1
+}
+
+// Matched returns the keywords found in the resume
+func (r Report) Matched() []Keyword {
+	return r.filter(true)
+}
+
+// Missing returns the keywords not found in the resume
+func (r Report) Missing() []Keyword {
+	return r.filter(false)
+}
+
+func (r Report) filter(matched bool) []Keyword {
+	var result []Keyword
+	for _, k := range r.Keywords {
+		if k.Matched() == matched {
+			result = append(result, k)
+		}
+	}
+	return result
+}
+
+// Options tunes keyword extraction
+type Options struct {
+	MaxKeywords int // Maximum number of non-skill keywords; 0 means 20
+	MinCount    int // Minimum occurrences for a non-skill keyword; 0 means 2
+}
+
+// Analyze extracts keywords from the job posting and checks which of them
+// appear in the resume's skills, experience and projects
+func Analyze(resume *models.Resume, jobText string, opts Options) Report {
+	if opts.MaxKeywords <= 0 {
+		opts.MaxKeywords = 20
+	}
+	if opts.MinCount <= 0 {
+		opts.MinCount = 2
+	}
+
+	// The resume's own skills extend the dictionary so that its terms are
+	// recognized in the posting, including Chinese ones
+	t := newTokenizer(skillTerms)
+	for _, term := range resumeSkillTerms(resume) {
+		t.add(term)
+	}
+
+	skillsArea := t.termSet(skillsText(resume))
+	experienceArea := t.termSet(experienceText(resume))
+	projectsArea := t.termSet(projectsText(resume))
+
+	counts := make(map[string]int)
+	var order []string
+	for _, tok := range t.tokenize(jobText) {
+		if !t.isKeyword(tok) {
+			continue
+		}
+		if counts[tok.term] == 0 {
+			order = append(order, tok.term)
+		}
+		counts[tok.term]++
+	}
+
+	var skills, others []Keyword
+	for _, term := range order {
+		k := Keyword{
+			Term:         term,
+			Count:        counts[term],
+			Skill:        t.skills[term],
+			InSkills:     skillsArea[term],
+			InExperience: experienceArea[term],
+			InProjects:   projectsArea[term],
+		}
+		if k.Skill {
+			k.Weight = skillWeight
+			skills = append(skills, k)
+		} else if k.Count >= opts.MinCount {
+			k.Weight = keywordWeight
+			others = append(others, k)
+		}
+	}
+
+	byCount := func(list []Keyword) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Count > list[j].Count })
+	}
+	byCount(skills)
+	byCount(others)
+	if len(others) > opts.MaxKeywords {
+		others = others[:opts.MaxKeywords]
+	}
+
+	report := Report{Keywords: append(skills, others...)}
+	var total, matched float64
+	for _, k := range report.Keywords {
+		total += k.Weight
+		if k.Matched() {
+			matched += k.Weight
+		}
+	}
+	if total > 0 {
+		report.Coverage = matched / total
+	}
+	return report
+}
+
+// isKeyword reports whether a token from the posting is worth reporting.
+// Only the ambiguous word itself must be capitalized; an alias such as
+// "golang" for go is unambiguous in any case.
+func (t *tokenizer) isKeyword(tok token) bool {
+	if caseSensitive[strings.ToLower(tok.surface)] {
+		first, _ := utf8.DecodeRuneInString(tok.surface)
+		return unicode.IsUpper(first)
+	}
+	if t.skills[tok.term] {
+		return true
+	}
+	if stopwords[tok.term] || len([]rune(tok.term)) < 3 {
+		return false
+	}
+	// Plain numbers ("5", "2024") are not keywords
+	return strings.IndexFunc(tok.term, unicode.IsLetter) >= 0 || strings.IndexFunc(tok.term, textutil.IsCJK) >= 0
+}
+
+// termSet tokenizes text line by line into a set of canonical terms, so
+// that phrases never span two entries
+func (t *tokenizer) termSet(text string) map[string]bool {
+	set := make(map[string]bool)
+	for _, line := range strings.Split(text, "\n") {
+		for _, tok := range t.tokenize(line) {
+			set[tok.term] = true
+			// "Kafka Streams" also covers "Kafka"
+			for _, word := range strings.Fields(tok.term) {
+				set[canonical(word)] = true
+			}
+		}
+	}
+	return set
+}
+
+func resumeSkillTerms(r *models.Resume) []string {
+	terms := r.Skills.All().Names()
+	for _, exp := range r.Experience {
+		terms = append(terms, exp.Technologies...)
+	}
+	for _, p := range r.Projects {
+		terms = append(terms, p.Technologies...)
+	}
+	return terms
+}
+
+func skillsText(r *models.Resume) string {
+	return strings.Join(r.Skills.All().Names(), "\n")
+}
+
+func experienceText(r *models.Resume) string {
+	var parts []string
+	for _, exp := range r.Experience {
+		for _, role := range exp.Positions() {
+			parts = append(parts, role.Position)
+		}
+		parts = append(parts, exp.AllResponsibilities()...)
+		parts = append(parts, exp.AllAchievements()...)
+		parts = append(parts, exp.Technologies...)
+	}
+	return strings.Join(parts, "\n")
+}
+
+func projectsText(r *models.Resume) string {
+	var parts []string
+	for _, p := range r.Projects {
+		parts = append(parts, p.Name, p.Description)
+		parts = append(parts, p.Technologies...)
+		parts = append(parts, p.Details...)
+	}
+	return strings.Join(parts, "\n")
+}
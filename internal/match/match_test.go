@@ -0,0 +1,59 @@
+package match
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tok := newTokenizer(skillTerms)
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"Golang and Kubernetes", []string{"go", "and", "kubernetes"}},
+		{"RESTful APIs on k8s", []string{"rest", "apis", "on", "kubernetes"}},
+		{"C++ / Node.js developer", []string{"c++", "node.js", "developer"}},
+		{"frontend/backend", []string{"frontend", "backend"}},
+		{"熟悉Go和分布式系统", []string{"go", "分布式"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, token := range tok.tokenize(tt.text) {
+			got = append(got, token.term)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestIsKeyword(t *testing.T) {
+	tok := newTokenizer(skillTerms)
+	tests := []struct {
+		surface string
+		want    bool
+	}{
+		{"Go", true},
+		{"go", false},
+		{"golang", true},
+		{"Golang", true},
+		{"REST", true},
+		{"rest", false},
+		{"restful", true},
+		{"Kubernetes", true},
+		{"kubernetes", true},
+		{"the", false},
+		{"2024", false},
+		{"distributed", true},
+	}
+	for _, tt := range tests {
+		tokens := tok.tokenize(tt.surface)
+		if len(tokens) != 1 {
+			t.Fatalf("tokenize(%q) = %v, want one token", tt.surface, tokens)
+		}
+		if got := tok.isKeyword(tokens[0]); got != tt.want {
+			t.Errorf("isKeyword(%q) = %v, want %v", tt.surface, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package match
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/loveRyujin/ResuGo/internal/textutil"
+)
+
+// token is a normalized term found in text
+type token struct {
+	term    string // Canonical lowercase form used for matching
+	surface string // Text as written, for display
+}
+
+// tokenizer splits English and Chinese text into terms. English is split on
+// word boundaries with known multi-word phrases merged; Chinese, which has no
+// spaces, is segmented by forward maximum matching against the dictionary.
+type tokenizer struct {
+	skills    map[string]bool // Canonical forms of every dictionary term
+	phrases   map[string]bool // Lowercase multi-word English phrases
+	cjkTerms  map[string]bool // Chinese dictionary
+	maxCJKLen int             // Longest Chinese term in runes
+}
+
+func newTokenizer(terms []string) *tokenizer {
+	t := &tokenizer{
+		skills:   make(map[string]bool),
+		phrases:  make(map[string]bool),
+		cjkTerms: make(map[string]bool),
+	}
+	for _, term := range terms {
+		t.add(term)
+	}
+	return t
+}
+
+// add registers a dictionary term
+func (t *tokenizer) add(term string) {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return
+	}
+	t.skills[canonical(term)] = true
+	if first, _ := utf8.DecodeRuneInString(term); textutil.IsCJK(first) {
+		t.cjkTerms[term] = true
+		if n := utf8.RuneCountInString(term); n > t.maxCJKLen {
+			t.maxCJKLen = n
+		}
+		return
+	}
+	if strings.Contains(term, " ") {
+		t.phrases[term] = true
+	}
+}
+
+// tokenize returns the terms of text in order
+func (t *tokenizer) tokenize(text string) []token {
+	var tokens []token
+	var run []rune
+	runIsCJK := false
+
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		if runIsCJK {
+			tokens = append(tokens, t.segmentCJK(run)...)
+		} else {
+			tokens = append(tokens, t.splitLatin(string(run))...)
+		}
+		run = run[:0]
+	}
+
+	for _, r := range text {
+		if cjk := textutil.IsCJK(r); cjk != runIsCJK {
+			flush()
+			runIsCJK = cjk
+		}
+		run = append(run, r)
+	}
+	flush()
+	return tokens
+}
+
+// segmentCJK splits a run of Chinese characters using forward maximum
+// matching. Characters not covered by a dictionary term are dropped, since
+// single characters carry little meaning on their own.
+func (t *tokenizer) segmentCJK(run []rune) []token {
+	var tokens []token
+	for i := 0; i < len(run); {
+		matched := 0
+		for n := min(t.maxCJKLen, len(run)-i); n >= 2; n-- {
+			if t.cjkTerms[string(run[i:i+n])] {
+				matched = n
+				break
+			}
+		}
+		if matched == 0 {
+			i++
+			continue
+		}
+		word := string(run[i : i+matched])
+		tokens = append(tokens, token{term: canonical(word), surface: word})
+		i += matched
+	}
+	return tokens
+}
+
+// splitLatin splits English text into words, keeping symbols that are part of
+// technology names (C++, C#, Node.js, CI/CD) and merging known phrases
+func (t *tokenizer) splitLatin(text string) []token {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("+#./-", r))
+	})
+
+	var words []token
+	for _, field := range fields {
+		field = strings.TrimRight(strings.TrimLeft(field, "./-"), ".-/")
+		if field == "" {
+			continue
+		}
+		lower := strings.ToLower(field)
+		// Slash-separated lists ("frontend/backend") are split unless the whole is a term
+		if strings.Contains(lower, "/") && !t.skills[canonical(lower)] {
+			for _, part := range strings.Split(field, "/") {
+				if part != "" {
+					words = append(words, token{term: strings.ToLower(part), surface: part})
+				}
+			}
+			continue
+		}
+		words = append(words, token{term: lower, surface: field})
+	}
+
+	// Merge the longest known phrase at each position
+	var tokens []token
+	for i := 0; i < len(words); {
+		merged := false
+		for n := 3; n >= 2; n-- {
+			if i+n > len(words) {
+				continue
+			}
+			parts := make([]string, n)
+			surfaces := make([]string, n)
+			for k := 0; k < n; k++ {
+				parts[k] = words[i+k].term
+				surfaces[k] = words[i+k].surface
+			}
+			if phrase := strings.Join(parts, " "); t.phrases[phrase] || t.phrases[canonical(phrase)] {
+				tokens = append(tokens, token{term: canonical(phrase), surface: strings.Join(surfaces, " ")})
+				i += n
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			tokens = append(tokens, token{term: canonical(words[i].term), surface: words[i].surface})
+			i++
+		}
+	}
+	return tokens
+}
+
+// canonical maps a lowercase term to its canonical spelling
+func canonical(term string) string {
+	if alias, ok := aliases[term]; ok {
+		return alias
+	}
+	return term
+}
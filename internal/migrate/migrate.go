@@ -0,0 +1,251 @@
+// Package migrate upgrades resume files to newer parts of the schema. It
+// edits the YAML document in place so comments, key order and include tags
+// survive.
+package migrate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// Promotion records a custom section moved into a typed section
+type Promotion struct {
+	Title   string   `json:"title"`          // Title of the custom section
+	Section string   `json:"section"`        // Key of the typed section it moved to
+	Items   int      `json:"items"`          // Number of items moved
+	Kept    []string `json:"kept,omitempty"` // Items left in the custom section because a required field could not be filled
+}
+
+// titles maps normalized custom section titles to the typed section they
+// describe, in English and Chinese
+var titles = map[string]string{
+	"certifications":              models.SectionCertifications,
+	"certification":               models.SectionCertifications,
+	"certificates":                models.SectionCertifications,
+	"licenses & certifications":   models.SectionCertifications,
+	"licenses and certifications": models.SectionCertifications,
+	"证书":                          models.SectionCertifications,
+	"资格证书":                        models.SectionCertifications,
+	"获得证书":                        models.SectionCertifications,
+	"专业证书":                        models.SectionCertifications,
+	"awards":                      models.SectionAwards,
+	"honors":                      models.SectionAwards,
+	"honours":                     models.SectionAwards,
+	"honors & awards":             models.SectionAwards,
+	"honors and awards":           models.SectionAwards,
+	"awards & honors":             models.SectionAwards,
+	"awards and honors":           models.SectionAwards,
+	"奖项":                          models.SectionAwards,
+	"荣誉":                          models.SectionAwards,
+	"荣誉奖项":                        models.SectionAwards,
+	"获奖经历":                        models.SectionAwards,
+	"获奖情况":                        models.SectionAwards,
+	"publications":                models.SectionPublications,
+	"selected publications":       models.SectionPublications,
+	"papers":                      models.SectionPublications,
+	"论文":                          models.SectionPublications,
+	"论文发表":                        models.SectionPublications,
+	"发表论文":                        models.SectionPublications,
+	"volunteer":                   models.SectionVolunteer,
+	"volunteering":                models.SectionVolunteer,
+	"volunteer experience":        models.SectionVolunteer,
+	"volunteer work":              models.SectionVolunteer,
+	"community service":           models.SectionVolunteer,
+	"志愿活动":                        models.SectionVolunteer,
+	"志愿经历":                        models.SectionVolunteer,
+	"志愿服务":                        models.SectionVolunteer,
+	"志愿者经历":                       models.SectionVolunteer,
+	"志愿者活动":                       models.SectionVolunteer,
+}
+
+// SectionFor returns the typed section a custom section title describes
+func SectionFor(title string) (string, bool) {
+	section, ok := titles[strings.ToLower(strings.Join(strings.Fields(title), " "))]
+	return section, ok
+}
+
+// Additional moves custom sections whose title names a typed section, such
+// as "Certifications" or "获奖经历", into that section. Items are parsed from
+// the common "name - issuer (2021)" form; text that does not fit stays in the
+// name or title. Items that would lack a required field, such as a volunteer
+// role without an organization, stay in the custom section to be edited by
+// hand. Other custom sections are left alone.
+func Additional(root *yaml.Node) ([]Promotion, error) {
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("a resume must be a YAML mapping")
+	}
+	additional := value(root, "additional")
+	if additional == nil || additional.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+
+	var promotions []Promotion
+	var kept []*yaml.Node
+	for _, node := range additional.Content {
+		var section models.Section
+		if err := node.Decode(&section); err != nil {
+			return nil, fmt.Errorf("additional: %w", err)
+		}
+		key, ok := SectionFor(section.Title)
+		if !ok {
+			kept = append(kept, node)
+			continue
+		}
+
+		promotion := Promotion{Title: section.Title, Section: key}
+		var entries []*yaml.Node
+		for _, item := range section.Items {
+			parsed := parse(key, item)
+			if !complete(parsed) {
+				promotion.Kept = append(promotion.Kept, item)
+				continue
+			}
+			entry := &yaml.Node{}
+			if err := entry.Encode(parsed); err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		}
+		promotion.Items = len(entries)
+
+		if len(entries) > 0 {
+			target := value(root, key)
+			if target == nil {
+				target = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+				insertBefore(root, "additional", key, target)
+			}
+			target.Content = append(target.Content, entries...)
+		}
+		if len(promotion.Kept) > 0 {
+			if items := value(node, "items"); items != nil {
+				if err := items.Encode(promotion.Kept); err != nil {
+					return nil, err
+				}
+			}
+			kept = append(kept, node)
+		}
+		promotions = append(promotions, promotion)
+	}
+
+	additional.Content = kept
+	if len(kept) == 0 {
+		remove(root, "additional")
+	}
+	return promotions, nil
+}
+
+var (
+	// A trailing date in brackets or after a comma: "(2021)", "（2021-05）", ", 2021"
+	trailingDate = regexp.MustCompile(`\s*(?:[(（]\s*([^()（）]*\d{4}[^()（）]*?)\s*[)）]|[,，]\s*(\d{4}(?:[-./]\d{1,2})?))\s*$`)
+	yearMonth    = regexp.MustCompile(`(\d{4})(?:\s*[-./年]\s*(\d{1,2})\b)?`)
+	separator    = regexp.MustCompile(`\s+[-–—|]\s+|\s*[|｜]\s*`)
+)
+
+// parse converts a plain custom section item into a typed entry
+func parse(section, item string) any {
+	text, dates := splitDates(item)
+	parts := separator.Split(text, 2)
+	first, second := strings.TrimSpace(parts[0]), ""
+	if len(parts) > 1 {
+		second = strings.TrimSpace(parts[1])
+	}
+	var start, end time.Time
+	if len(dates) > 0 {
+		start, end = dates[0], dates[len(dates)-1]
+	}
+
+	switch section {
+	case models.SectionCertifications:
+		return models.Certification{Name: first, Issuer: second, Date: start}
+	case models.SectionAwards:
+		return models.Award{Title: first, Issuer: second, Date: start}
+	case models.SectionPublications:
+		pub := models.Publication{Title: first, Venue: second}
+		if !start.IsZero() {
+			pub.Year = start.Year()
+		}
+		return pub
+	default:
+		v := models.Volunteer{Role: first, Organization: second, StartDate: start, EndDate: end}
+		if strings.Contains(item, "至今") || strings.Contains(strings.ToLower(item), "present") {
+			v.Current, v.EndDate = true, time.Time{}
+		}
+		return v
+	}
+}
+
+// complete reports whether a parsed entry has every required field
+func complete(entry any) bool {
+	switch e := entry.(type) {
+	case models.Certification:
+		return e.Name != ""
+	case models.Award:
+		return e.Title != ""
+	case models.Publication:
+		return e.Title != ""
+	case models.Volunteer:
+		return e.Role != "" && e.Organization != ""
+	}
+	return true
+}
+
+// splitDates removes a trailing date or date range from s
+func splitDates(s string) (string, []time.Time) {
+	m := trailingDate.FindStringSubmatchIndex(s)
+	if m == nil {
+		return strings.TrimSpace(s), nil
+	}
+	var dates []time.Time
+	for _, ym := range yearMonth.FindAllStringSubmatch(s[m[0]:], -1) {
+		year, _ := strconv.Atoi(ym[1])
+		month := 1
+		if ym[2] != "" {
+			if n, _ := strconv.Atoi(ym[2]); n >= 1 && n <= 12 {
+				month = n
+			}
+		}
+		dates = append(dates, time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC))
+	}
+	return strings.TrimSpace(s[:m[0]]), dates
+}
+
+// value returns the value node of key in a mapping, or nil
+func value(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// insertBefore adds key: val before the existing key before, or at the end
+func insertBefore(mapping *yaml.Node, before, key string, val *yaml.Node) {
+	pair := []*yaml.Node{{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, val}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == before {
+			mapping.Content = append(mapping.Content[:i], append(pair, mapping.Content[i:]...)...)
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, pair...)
+}
+
+// remove deletes key from a mapping
+func remove(mapping *yaml.Node, key string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Award represents an honor, prize or scholarship
+type Award struct {
+	Title       string    `yaml:"title"`
+	Issuer      string    `yaml:"issuer,omitempty"`
+	Date        time.Time `yaml:"date,omitempty"`
+	Description string    `yaml:"description,omitempty"`
+}
+
+// FormatDate formats the award date for display, or "" when undated
+func (a *Award) FormatDate() string {
+	if a.Date.IsZero() {
+		return ""
+	}
+	return a.Date.Format("Jan 2006")
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Certification represents a professional certificate or license
+type Certification struct {
+	Name         string    `yaml:"name"`
+	Issuer       string    `yaml:"issuer,omitempty"`
+	Date         time.Time `yaml:"date,omitempty"`    // When it was earned
+	Expires      time.Time `yaml:"expires,omitempty"` // Zero for certifications that do not expire
+	CredentialID string    `yaml:"credential_id,omitempty"`
+	URL          string    `yaml:"url,omitempty"` // Verification link
+}
+
+// FormatDate formats the date earned for display, or "" when undated
+func (c *Certification) FormatDate() string {
+	if c.Date.IsZero() {
+		return ""
+	}
+	return c.Date.Format("Jan 2006")
+}
+
+// FormatExpires formats the expiry date for display, or "" when it does not expire
+func (c *Certification) FormatExpires() string {
+	if c.Expires.IsZero() {
+		return ""
+	}
+	return c.Expires.Format("Jan 2006")
+}
@@ -0,0 +1,18 @@
+package models
+
+// CoverLetter holds the per-application parts of a cover letter. Text fields
+// may use template placeholders such as {{.Company}}, {{.Role}},
+// {{.HiringManager}} and {{.Personal.Name}}.
+type CoverLetter struct {
+	Company        string   `yaml:"company"`
+	Role           string   `yaml:"role"`
+	HiringManager  string   `yaml:"hiring_manager,omitempty"`
+	CompanyAddress []string `yaml:"company_address,omitempty"`
+	Date           string   `yaml:"date,omitempty"`       // Shown as written; defaults to today
+	Greeting       string   `yaml:"greeting,omitempty"`   // Replaces the default salutation
+	Opening        string   `yaml:"opening,omitempty"`    // Replaces the default first paragraph
+	Paragraphs     []string `yaml:"paragraphs,omitempty"` // Custom body paragraphs
+	Highlights     []string `yaml:"highlights,omitempty"` // Experiences to feature, by company or "company / position"
+	Closing        string   `yaml:"closing,omitempty"`    // Replaces the default last paragraph
+	SignOff        string   `yaml:"sign_off,omitempty"`   // e.g. "Best regards,"
+}
@@ -15,6 +15,7 @@ type Education struct {
 	RelevantCourses []string  `yaml:"relevant_courses,omitempty"`
 	HonorsAwards    []string  `yaml:"honors_awards,omitempty"`
 	Description     string    `yaml:"description,omitempty"`
+	Priority        string    `yaml:"priority,omitempty"` // "low" entries may be dropped to fit a page limit
 }
 
 // FormatStartDate formats the start date for display
@@ -29,3 +30,11 @@ func (edu *Education) FormatEndDate() string {
 	}
 	return edu.EndDate.Format("2006")
 }
+
+// DateRange formats "start - end" for display, or "" when the entry is undated
+func (edu *Education) DateRange() string {
+	if edu.StartDate.IsZero() {
+		return ""
+	}
+	return edu.FormatStartDate() + " - " + edu.FormatEndDate()
+}
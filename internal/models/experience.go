@@ -2,27 +2,161 @@ package models
 
 import "time"
 
-// Experience represents work experience
+// Experience represents work experience. An experience either describes a
+// single position through its own fields or lists several Roles held at the
+// same company, in which case the top-level position and dates are optional
+// and top-level responsibilities and achievements describe the company as a
+// whole.
 type Experience struct {
 	Company          string    `yaml:"company"`
-	Position         string    `yaml:"position"`
+	Position         string    `yaml:"position,omitempty"`
 	Location         string    `yaml:"location"`
+	Type             string    `yaml:"type,omitempty"` // full-time (default), part-time, internship, contract, freelance
+	StartDate        time.Time `yaml:"start_date,omitempty"`
+	EndDate          time.Time `yaml:"end_date,omitempty"`
+	Current          bool      `yaml:"current,omitempty"`
+	Responsibilities []string  `yaml:"responsibilities,omitempty"`
+	Achievements     []string  `yaml:"achievements,omitempty"`
+	Roles            []Role    `yaml:"roles,omitempty"`        // Successive positions at the same company
+	Technologies     []string  `yaml:"technologies,omitempty"` // Tags used to infer per-skill experience
+	Priority         string    `yaml:"priority,omitempty"`     // "low" entries may be dropped to fit a page limit
+}
+
+// Role is one position held at a company that lists several
+type Role struct {
+	Position         string    `yaml:"position"`
 	StartDate        time.Time `yaml:"start_date"`
-	EndDate          time.Time `yaml:"end_date"`
-	Current          bool      `yaml:"current"`
-	Responsibilities []string  `yaml:"responsibilities"`
+	EndDate          time.Time `yaml:"end_date,omitempty"`
+	Current          bool      `yaml:"current,omitempty"`
+	Responsibilities []string  `yaml:"responsibilities,omitempty"`
 	Achievements     []string  `yaml:"achievements,omitempty"`
 }
 
-// FormatStartDate formats the start date for display
+// FormatStartDate formats the role start date for display
+func (r *Role) FormatStartDate() string {
+	return r.StartDate.Format("Jan 2006")
+}
+
+// FormatEndDate formats the role end date for display
+func (r *Role) FormatEndDate() string {
+	if r.Current {
+		return "Present"
+	}
+	return r.EndDate.Format("Jan 2006")
+}
+
+// DateRange formats "start - end" for display, or "" when the role is undated
+func (r *Role) DateRange() string {
+	if r.StartDate.IsZero() {
+		return ""
+	}
+	return r.FormatStartDate() + " - " + r.FormatEndDate()
+}
+
+// HasRoles reports whether the experience is split into several roles
+func (e *Experience) HasRoles() bool {
+	return len(e.Roles) > 0
+}
+
+// Positions returns the roles held at the company. An experience without
+// roles yields a single role built from its own fields, so callers can treat
+// both shapes alike.
+func (e *Experience) Positions() []Role {
+	if e.HasRoles() {
+		return e.Roles
+	}
+	return []Role{{
+		Position:         e.Position,
+		StartDate:        e.StartDate,
+		EndDate:          e.EndDate,
+		Current:          e.Current,
+		Responsibilities: e.Responsibilities,
+		Achievements:     e.Achievements,
+	}}
+}
+
+// Tenure returns the overall time spent at the company: the earliest role
+// start, the latest role end, and whether any role is still current.
+// Explicit top-level dates take precedence over the ones derived from roles.
+func (e *Experience) Tenure() (start, end time.Time, current bool) {
+	start, end, current = e.StartDate, e.EndDate, e.Current
+	for _, role := range e.Roles {
+		if e.StartDate.IsZero() && !role.StartDate.IsZero() && (start.IsZero() || role.StartDate.Before(start)) {
+			start = role.StartDate
+		}
+		if e.EndDate.IsZero() && role.EndDate.After(end) {
+			end = role.EndDate
+		}
+		current = current || role.Current
+	}
+	return start, end, current
+}
+
+// Title returns the position to show for the company as a whole: the
+// top-level position, or the most recent role when only roles are given
+func (e *Experience) Title() string {
+	if e.Position != "" || !e.HasRoles() {
+		return e.Position
+	}
+	latest := e.Roles[0]
+	for _, role := range e.Roles[1:] {
+		if role.StartDate.After(latest.StartDate) {
+			latest = role
+		}
+	}
+	return latest.Position
+}
+
+// AllResponsibilities returns the company-wide responsibilities and those of
+// every role
+func (e *Experience) AllResponsibilities() []string {
+	var out []string
+	if e.HasRoles() {
+		out = append(out, e.Responsibilities...)
+	}
+	for _, role := range e.Positions() {
+		out = append(out, role.Responsibilities...)
+	}
+	return out
+}
+
+// AllAchievements returns the company-wide achievements and those of every
+// role
+func (e *Experience) AllAchievements() []string {
+	var out []string
+	if e.HasRoles() {
+		out = append(out, e.Achievements...)
+	}
+	for _, role := range e.Positions() {
+		out = append(out, role.Achievements...)
+	}
+	return out
+}
+
+// FormatStartDate formats the tenure start date for display
 func (e *Experience) FormatStartDate() string {
-	return e.StartDate.Format("Jan 2006")
+	start, _, _ := e.Tenure()
+	return start.Format("Jan 2006")
 }
 
-// FormatEndDate formats the end date for display
+// FormatEndDate formats the tenure end date for display
 func (e *Experience) FormatEndDate() string {
-	if e.Current {
+	_, end, current := e.Tenure()
+	if current {
 		return "Present"
 	}
-	return e.EndDate.Format("Jan 2006")
+	return end.Format("Jan 2006")
+}
+
+// IsFullTime reports whether the role is full-time; roles without a type count as full-time
+func (e *Experience) IsFullTime() bool {
+	return e.Type == "" || e.Type == "full-time"
+}
+
+// DateRange formats "start - end" for display, or "" when the entry is undated
+func (e *Experience) DateRange() string {
+	if start, _, _ := e.Tenure(); start.IsZero() {
+		return ""
+	}
+	return e.FormatStartDate() + " - " + e.FormatEndDate()
 }
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Section keys accepted by Layout
+const (
+	SectionSummary        = "summary"
+	SectionEducation      = "education"
+	SectionExperience     = "experience"
+	SectionProjects       = "projects"
+	SectionSkills         = "skills"
+	SectionCertifications = "certifications"
+	SectionAwards         = "awards"
+	SectionPublications   = "publications"
+	SectionVolunteer      = "volunteer"
+	SectionLanguages      = "languages"
+	SectionAdditional     = "additional"
+)
+
+// DefaultSectionOrder is the order used when no layout is configured
+var DefaultSectionOrder = []string{
+	SectionSummary,
+	SectionEducation,
+	SectionExperience,
+	SectionProjects,
+	SectionSkills,
+	SectionCertifications,
+	SectionAwards,
+	SectionPublications,
+	SectionVolunteer,
+	SectionLanguages,
+	SectionAdditional,
+}
+
+// Layout controls which sections are rendered, in what order and under which heading
+type Layout struct {
+	Sections []string          `yaml:"sections,omitempty"` // Explicit order; unlisted sections are omitted
+	Hidden   []string          `yaml:"hidden,omitempty"`   // Sections to skip
+	Titles   map[string]string `yaml:"titles,omitempty"`   // Heading overrides keyed by section
+}
+
+// IsZero reports whether the layout has no settings
+func (l Layout) IsZero() bool {
+	return len(l.Sections) == 0 && len(l.Hidden) == 0 && len(l.Titles) == 0
+}
+
+// Validate checks that every referenced section key is known
+func (l Layout) Validate() error {
+	check := func(field, key string) error {
+		if !isSectionKey(key) {
+			return fmt.Errorf("layout.%s: unknown section %q (valid: %s)",
+				field, key, strings.Join(DefaultSectionOrder, ", "))
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range l.Sections {
+		if err := check("sections", key); err != nil {
+			return err
+		}
+		if seen[key] {
+			return fmt.Errorf("layout.sections: section %q listed more than once", key)
+		}
+		seen[key] = true
+	}
+	for _, key := range l.Hidden {
+		if err := check("hidden", key); err != nil {
+			return err
+		}
+	}
+	for key := range l.Titles {
+		if err := check("titles", key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Order returns the section keys to render, in order, with hidden sections removed
+func (l Layout) Order() []string {
+	order := DefaultSectionOrder
+	if len(l.Sections) > 0 {
+		order = l.Sections
+	}
+
+	var result []string
+	for _, key := range order {
+		if !l.isHidden(key) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// Title returns the heading for a section, falling back to the given default
+func (l Layout) Title(key, fallback string) string {
+	if title := strings.TrimSpace(l.Titles[key]); title != "" {
+		return title
+	}
+	return fallback
+}
+
+// Merge returns a layout where every setting present in override replaces the
+// one in l. A list given but empty, as in "hidden: []", is present and clears
+// the inherited one; only an absent list (nil) inherits.
+func (l Layout) Merge(override Layout) Layout {
+	result := l
+	if override.Sections != nil {
+		result.Sections = override.Sections
+	}
+	if override.Hidden != nil {
+		result.Hidden = override.Hidden
+	}
+	if len(override.Titles) > 0 {
+		titles := make(map[string]string, len(l.Titles)+len(override.Titles))
+		for key, title := range l.Titles {
+			titles[key] = title
+		}
+		for key, title := range override.Titles {
+			titles[key] = title
+		}
+		result.Titles = titles
+	}
+	return result
+}
+
+func (l Layout) isHidden(key string) bool {
+	for _, hidden := range l.Hidden {
+		if hidden == key {
+			return true
+		}
+	}
+	return false
+}
+
+func isSectionKey(key string) bool {
+	for _, known := range DefaultSectionOrder {
+		if known == key {
+			return true
+		}
+	}
+	return false
+}
@@ -3,11 +3,20 @@ package models
 // PersonalInfo represents personal basic information
 type PersonalInfo struct {
 	Name     string `yaml:"name"`
-	Title    string `yaml:"title,omitempty"`
+	Title    string `yaml:"title,omitempty"` // Headline under the name, e.g. "Backend Engineer"
 	Email    string `yaml:"email"`
 	Phone    string `yaml:"phone"`
 	Location string `yaml:"location"`
 	Website  string `yaml:"website,omitempty"`
-	GitHub   string `yaml:"github,omitempty"`
-	LinkedIn string `yaml:"linkedin,omitempty"`
+	GitHub   string `yaml:"github,omitempty"`   // Username or profile URL
+	LinkedIn string `yaml:"linkedin,omitempty"` // Username or profile URL
+	Links    []Link `yaml:"links,omitempty"`    // Further contacts shown after the built-in ones
+	Photo    string `yaml:"photo,omitempty"`    // JPEG or PNG path, relative to the resume file; HTML and PDF only
+}
+
+// Link is an additional contact such as a blog or portfolio
+type Link struct {
+	Label string `yaml:"label"`
+	URL   string `yaml:"url"`
+	Icon  string `yaml:"icon,omitempty"` // Short symbol or emoji shown before the label in Markdown and HTML
 }
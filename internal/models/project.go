@@ -14,6 +14,7 @@ type Project struct {
 	URL          string    `yaml:"url,omitempty"`
 	Repository   string    `yaml:"repository,omitempty"`
 	Details      []string  `yaml:"details"`
+	Priority     string    `yaml:"priority,omitempty"` // "low" entries may be dropped to fit a page limit
 }
 
 // FormatStartDate formats the start date for display
@@ -28,3 +29,11 @@ func (p *Project) FormatEndDate() string {
 	}
 	return p.EndDate.Format("Jan 2006")
 }
+
+// DateRange formats "start - end" for display, or "" when the entry is undated
+func (p *Project) DateRange() string {
+	if p.StartDate.IsZero() {
+		return ""
+	}
+	return p.FormatStartDate() + " - " + p.FormatEndDate()
+}
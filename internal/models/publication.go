@@ -0,0 +1,40 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Publication represents a paper, article or book
+type Publication struct {
+	Title   string   `yaml:"title"`
+	Authors []string `yaml:"authors,omitempty"` // In published order, including the resume's owner
+	Venue   string   `yaml:"venue,omitempty"`   // Journal, conference or publisher
+	Year    int      `yaml:"year,omitempty"`
+	DOI     string   `yaml:"doi,omitempty"`
+	URL     string   `yaml:"url,omitempty"`
+}
+
+// Citation formats the publication as "Authors. Title. Venue, Year. doi:DOI"
+func (p *Publication) Citation() string {
+	var parts []string
+	if len(p.Authors) > 0 {
+		parts = append(parts, strings.Join(p.Authors, ", "))
+	}
+	parts = append(parts, p.Title)
+	switch {
+	case p.Venue != "" && p.Year > 0:
+		parts = append(parts, fmt.Sprintf("%s, %d", p.Venue, p.Year))
+	case p.Venue != "":
+		parts = append(parts, p.Venue)
+	case p.Year > 0:
+		parts = append(parts, fmt.Sprint(p.Year))
+	}
+	if p.DOI != "" {
+		parts = append(parts, "doi:"+p.DOI)
+	}
+	for i, part := range parts {
+		parts[i] = strings.TrimRight(part, ".")
+	}
+	return strings.Join(parts, ". ") + "."
+}
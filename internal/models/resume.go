@@ -1,13 +1,34 @@
 package models
 
+import "path/filepath"
+
 // Resume represents a complete resume
 type Resume struct {
-	PersonalInfo PersonalInfo `yaml:"personal_info"`
-	Summary      string       `yaml:"summary,omitempty"`
-	Education    []Education  `yaml:"education"`
-	Experience   []Experience `yaml:"experience"`
-	Projects     []Project    `yaml:"projects"`
-	Skills       Skills       `yaml:"skills"`
-	Languages    []Language   `yaml:"languages,omitempty"`
-	Additional   []Section    `yaml:"additional,omitempty"` // For custom sections
+	PersonalInfo   PersonalInfo    `yaml:"personal_info"`
+	Summary        string          `yaml:"summary,omitempty"`
+	Education      []Education     `yaml:"education"`
+	Experience     []Experience    `yaml:"experience"`
+	Projects       []Project       `yaml:"projects"`
+	Skills         Skills          `yaml:"skills"`
+	Certifications []Certification `yaml:"certifications,omitempty"`
+	Awards         []Award         `yaml:"awards,omitempty"`
+	Publications   []Publication   `yaml:"publications,omitempty"`
+	Volunteer      []Volunteer     `yaml:"volunteer,omitempty"`
+	Languages      []Language      `yaml:"languages,omitempty"`
+	Additional     []Section       `yaml:"additional,omitempty"` // For custom sections
+	Layout         Layout          `yaml:"layout,omitempty"`     // Section order, visibility and headings
+
+	// BaseDir is the directory of the file the resume was loaded from.
+	// Relative paths such as the photo are resolved against it.
+	BaseDir string `yaml:"-"`
+}
+
+// PhotoPath returns the photo path resolved against BaseDir, or "" when no
+// photo is set
+func (r *Resume) PhotoPath() string {
+	photo := r.PersonalInfo.Photo
+	if photo == "" || filepath.IsAbs(photo) {
+		return photo
+	}
+	return filepath.Join(r.BaseDir, photo)
 }
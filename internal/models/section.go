@@ -1,9 +1,24 @@
 package models
 
+import (
+	"slices"
+	"strings"
+)
+
 // Language represents language proficiency
 type Language struct {
-	Name  string `yaml:"name"`
-	Level string `yaml:"level"` // native, fluent, conversational, basic
+	Name          string `yaml:"name"`
+	Level         string `yaml:"level"`                   // native, fluent, conversational, basic
+	Certification string `yaml:"certification,omitempty"` // Standardized result, e.g. "CEFR C1", "IELTS 7.5", "HSK 5"
+}
+
+// LanguageLevels lists the valid language levels from highest to lowest
+var LanguageLevels = []string{"native", "fluent", "conversational", "basic"}
+
+// ValidLanguageLevel reports whether level is one of LanguageLevels,
+// ignoring case
+func ValidLanguageLevel(level string) bool {
+	return slices.Contains(LanguageLevels, strings.ToLower(strings.TrimSpace(level)))
 }
 
 // Section represents additional custom sections
@@ -1,17 +1,151 @@
 package models
 
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
 // Skills represents all skills grouped by categories
 type Skills struct {
-	Languages  []string        `yaml:"languages,omitempty"`
-	Frameworks []string        `yaml:"frameworks,omitempty"`
-	Databases  []string        `yaml:"databases,omitempty"`
-	Tools      []string        `yaml:"tools,omitempty"`
-	Other      []string        `yaml:"other,omitempty"`
+	Languages  SkillList       `yaml:"languages,omitempty"`
+	Frameworks SkillList       `yaml:"frameworks,omitempty"`
+	Databases  SkillList       `yaml:"databases,omitempty"`
+	Tools      SkillList       `yaml:"tools,omitempty"`
+	Other      SkillList       `yaml:"other,omitempty"`
 	Custom     []SkillCategory `yaml:"custom,omitempty"` // For custom skill categories
 }
 
 // SkillCategory represents a custom skill category
 type SkillCategory struct {
-	Name  string   `yaml:"name"`
-	Items []string `yaml:"items"`
+	Name  string    `yaml:"name"`
+	Items SkillList `yaml:"items"`
+}
+
+// Skill is one skill with an optional proficiency level and years of use.
+// In YAML a skill without level or years is written as a plain string.
+type Skill struct {
+	Name  string  `yaml:"name"`
+	Level string  `yaml:"level,omitempty"` // 1-5, or beginner, intermediate, advanced, expert
+	Years float64 `yaml:"years,omitempty"`
+}
+
+// MaxSkillLevel is the top of the numeric proficiency scale
+const MaxSkillLevel = 5
+
+// skillLevels maps the named levels onto the numeric scale
+var skillLevels = map[string]int{
+	"beginner":     1,
+	"elementary":   2,
+	"intermediate": 3,
+	"advanced":     4,
+	"expert":       5,
+}
+
+// SkillLevelNames lists the named levels from lowest to highest
+var SkillLevelNames = []string{"beginner", "elementary", "intermediate", "advanced", "expert"}
+
+// ValidSkillLevel reports whether level is empty, 1-5 or a named level
+func ValidSkillLevel(level string) bool {
+	return level == "" || skillRank(level) > 0
+}
+
+func skillRank(level string) int {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if rank, ok := skillLevels[level]; ok {
+		return rank
+	}
+	if n, err := strconv.Atoi(level); err == nil && n >= 1 && n <= MaxSkillLevel {
+		return n
+	}
+	return 0
+}
+
+// Rank returns the level on the 1-5 scale, or 0 when no valid level is set
+func (s Skill) Rank() int {
+	return skillRank(s.Level)
+}
+
+// LevelName returns the named level ("expert", ...) for a named or numeric
+// level, or "" when none is set
+func (s Skill) LevelName() string {
+	if rank := s.Rank(); rank > 0 {
+		return SkillLevelNames[rank-1]
+	}
+	return ""
+}
+
+// UnmarshalYAML accepts either a plain skill name or a mapping with name,
+// level and years
+func (s *Skill) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*s = Skill{Name: value.Value}
+		return nil
+	}
+	type plain Skill
+	return value.Decode((*plain)(s))
+}
+
+// MarshalYAML writes skills without level or years as plain strings
+func (s Skill) MarshalYAML() (any, error) {
+	if s.Level == "" && s.Years == 0 {
+		return s.Name, nil
+	}
+	type plain Skill
+	return plain(s), nil
+}
+
+// SkillList is a list of skills
+type SkillList []Skill
+
+// Names returns the skill names in order
+func (l SkillList) Names() []string {
+	names := make([]string, len(l))
+	for i, skill := range l {
+		names[i] = skill.Name
+	}
+	return names
+}
+
+// HasLevels reports whether any skill in the list has a level or years
+func (l SkillList) HasLevels() bool {
+	for _, skill := range l {
+		if skill.Level != "" || skill.Years > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// SkillNames builds a list of skills without levels
+func SkillNames(names ...string) SkillList {
+	list := make(SkillList, len(names))
+	for i, name := range names {
+		list[i] = Skill{Name: name}
+	}
+	return list
+}
+
+// All returns every skill of every category in order
+func (s Skills) All() SkillList {
+	all := append(SkillList{}, s.Languages...)
+	all = append(all, s.Frameworks...)
+	all = append(all, s.Databases...)
+	all = append(all, s.Tools...)
+	all = append(all, s.Other...)
+	for _, category := range s.Custom {
+		all = append(all, category.Items...)
+	}
+	return all
+}
+
+// HasLevels reports whether any skill has a level or years
+func (s Skills) HasLevels() bool {
+	return s.All().HasLevels()
+}
+
+// IsEmpty reports whether no skills are listed in any category
+func (s Skills) IsEmpty() bool {
+	return len(s.All()) == 0
 }
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Volunteer represents an unpaid role with an organization
+type Volunteer struct {
+	Organization string    `yaml:"organization"`
+	Role         string    `yaml:"role"`
+	Location     string    `yaml:"location,omitempty"`
+	StartDate    time.Time `yaml:"start_date,omitempty"`
+	EndDate      time.Time `yaml:"end_date,omitempty"`
+	Current      bool      `yaml:"current,omitempty"`
+	Details      []string  `yaml:"details,omitempty"`
+}
+
+// FormatStartDate formats the start date for display
+func (v *Volunteer) FormatStartDate() string {
+	return v.StartDate.Format("Jan 2006")
+}
+
+// FormatEndDate formats the end date for display
+func (v *Volunteer) FormatEndDate() string {
+	if v.Current {
+		return "Present"
+	}
+	return v.EndDate.Format("Jan 2006")
+}
+
+// DateRange formats "start - end" for display, or "" when the entry is undated
+func (v *Volunteer) DateRange() string {
+	if v.StartDate.IsZero() {
+		return ""
+	}
+	return v.FormatStartDate() + " - " + v.FormatEndDate()
+}
@@ -0,0 +1,68 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg" // Register the JPEG decoder
+	_ "image/png"  // Register the PNG decoder
+)
+
+// Image is a raster image that can be drawn on any page of a document
+type Image struct {
+	Width, Height int // Size in pixels
+
+	colorSpace string
+	filter     string // DCTDecode for JPEG passed through, empty for raw samples
+	data       []byte
+}
+
+// DecodeImage prepares JPEG or PNG data for embedding. RGB and grayscale
+// JPEGs are embedded as is; other images are decoded to RGB samples, with
+// transparency composited onto white.
+func DecodeImage(data []byte) (*Image, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported image: %w", err)
+	}
+	if format == "jpeg" {
+		switch cfg.ColorModel {
+		case color.YCbCrModel:
+			return &Image{Width: cfg.Width, Height: cfg.Height, colorSpace: "DeviceRGB", filter: "DCTDecode", data: data}, nil
+		case color.GrayModel:
+			return &Image{Width: cfg.Width, Height: cfg.Height, colorSpace: "DeviceGray", filter: "DCTDecode", data: data}, nil
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	bounds := img.Bounds()
+	samples := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// Premultiplied components over a white background
+			r, g, b, a := img.At(x, y).RGBA()
+			white := 0xffff - a
+			samples = append(samples, byte((r+white)>>8), byte((g+white)>>8), byte((b+white)>>8))
+		}
+	}
+	return &Image{Width: bounds.Dx(), Height: bounds.Dy(), colorSpace: "DeviceRGB", data: samples}, nil
+}
+
+// Image draws img scaled to w by h points with its top-left corner at (x, y)
+func (d *Document) Image(x, y, w, h float64, img *Image) {
+	index := -1
+	for i, existing := range d.images {
+		if existing == img {
+			index = i
+		}
+	}
+	if index < 0 {
+		d.images = append(d.images, img)
+		index = len(d.images) - 1
+	}
+	fmt.Fprintf(d.page(), "q %.2f 0 0 %.2f %.2f %.2f cm /Im%d Do Q\n", w, h, x, d.Height-y-h, index+1)
+}
@@ -0,0 +1,106 @@
+package pdf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// latinWidths are the STSong-Light widths, in thousandths of an em, of the
+// printable ASCII characters from space to tilde (CIDs 1-95)
+var latinWidths = [95]int{
+	207, 270, 342, 467, 462, 797, 710, 239, 374, 374, 423, 605, 238, 375, 238, 334,
+	462, 462, 462, 462, 462, 462, 462, 462, 462, 462, 238, 238, 605, 605, 605, 344,
+	748, 684, 560, 695, 739, 563, 511, 729, 793, 318, 312, 666, 526, 896, 758, 772,
+	544, 772, 628, 465, 607, 753, 711, 972, 647, 620, 607, 374, 333, 374, 606, 500,
+	239, 417, 503, 427, 529, 415, 264, 444, 518, 241, 230, 495, 228, 793, 527, 524,
+	524, 504, 338, 336, 277, 517, 450, 652, 466, 452, 407, 370, 258, 370, 605,
+}
+
+// runeWidth returns the advance width of r in thousandths of an em
+func runeWidth(r rune) int {
+	switch {
+	case r >= 0x20 && r <= 0x7E:
+		return latinWidths[r-0x20]
+	case r < 0x2E80:
+		// Latin-1 and other alphabetic characters are drawn half-width
+		return 500
+	default:
+		return 1000
+	}
+}
+
+// TextWidth returns the width of s in points at the given font size
+func TextWidth(s string, size float64) float64 {
+	total := 0
+	for _, r := range s {
+		total += runeWidth(r)
+	}
+	return float64(total) * size / 1000
+}
+
+// widthArray builds the /W entry: proportional widths for ASCII and
+// half-width metrics for the alternate Latin range some CMaps select
+func widthArray() string {
+	parts := make([]string, len(latinWidths))
+	for i, w := range latinWidths {
+		parts[i] = fmt.Sprint(w)
+	}
+	return fmt.Sprintf("[1 [%s] 814 907 500]", strings.Join(parts, " "))
+}
+
+// Wrap breaks s into lines no wider than width at the given font size.
+// Latin text breaks at spaces; CJK text may break between any two characters.
+func Wrap(s string, size, width float64) []string {
+	var lines []string
+	var line, word []rune
+	lineWidth, wordWidth := 0.0, 0.0
+	unit := size / 1000
+
+	flushWord := func() {
+		if len(word) == 0 {
+			return
+		}
+		if lineWidth+wordWidth > width && len(line) > 0 {
+			lines = append(lines, strings.TrimRight(string(line), " "))
+			line, lineWidth = nil, 0
+		}
+		line = append(line, word...)
+		lineWidth += wordWidth
+		word, wordWidth = nil, 0
+	}
+
+	for _, r := range s {
+		w := float64(runeWidth(r)) * unit
+		switch {
+		case r == ' ':
+			flushWord()
+			if len(line) > 0 {
+				line = append(line, r)
+				lineWidth += w
+			}
+		case r >= 0x2E80:
+			// Each CJK character is its own breakable unit
+			flushWord()
+			word, wordWidth = []rune{r}, w
+			flushWord()
+		default:
+			word = append(word, r)
+			wordWidth += w
+			// Break overlong words such as URLs
+			if wordWidth > width {
+				last := word[len(word)-1]
+				word = word[:len(word)-1]
+				wordWidth -= w
+				flushWord()
+				lines = append(lines, string(line))
+				line, lineWidth = nil, 0
+				word, wordWidth = []rune{last}, w
+			}
+		}
+	}
+	flushWord()
+	if len(line) > 0 || len(lines) == 0 {
+		lines = append(lines, strings.TrimRight(string(line), " "))
+	}
+	return lines
+}
@@ -0,0 +1,243 @@
+// Package pdf writes simple text, shape and image PDF documents without external
+// dependencies. All text uses the standard STSong-Light CID font, which
+// covers both Latin and Chinese; it is not embedded, so viewers substitute a
+// locally installed font with the same metrics.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// Page sizes in points (1/72 inch)
+var pageSizes = map[string][2]float64{
+	"a4":     {595.28, 841.89},
+	"letter": {612, 792},
+}
+
+// PageSize returns the width and height of a named page size
+func PageSize(name string) (float64, float64, error) {
+	if name == "" {
+		name = "a4"
+	}
+	size, ok := pageSizes[strings.ToLower(name)]
+	if !ok {
+		return 0, 0, fmt.Errorf("unsupported page size %q (valid: A4, Letter)", name)
+	}
+	return size[0], size[1], nil
+}
+
+// Color is an RGB color with components from 0 to 1
+type Color struct{ R, G, B float64 }
+
+// Document is a PDF under construction. Coordinates passed to drawing
+// methods are measured from the top-left corner of the page.
+type Document struct {
+	Width, Height float64
+	Title         string
+	Author        string
+
+	pages  []*bytes.Buffer
+	images []*Image
+}
+
+// New creates an empty document with the given page size in points
+func New(width, height float64) *Document {
+	return &Document{Width: width, Height: height}
+}
+
+// AddPage starts a new page; drawing goes to the last page
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// PageCount returns the number of pages
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+func (d *Document) page() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+// Text draws s with its baseline at (x, y). Bold text is simulated by
+// stroking the glyph outlines.
+func (d *Document) Text(x, y, size float64, bold bool, color Color, s string) {
+	if s == "" {
+		return
+	}
+	p := d.page()
+	fmt.Fprintf(p, "%.3f %.3f %.3f rg\n", color.R, color.G, color.B)
+	if bold {
+		fmt.Fprintf(p, "%.3f %.3f %.3f RG 2 Tr %.2f w\n", color.R, color.G, color.B, size*0.03)
+	} else {
+		p.WriteString("0 Tr\n")
+	}
+	fmt.Fprintf(p, "BT /F1 %.2f Tf %.2f %.2f Td <%s> Tj ET\n", size, x, d.Height-y, encodeText(s))
+}
+
+// Line draws a straight line
+func (d *Document) Line(x1, y1, x2, y2, width float64, color Color) {
+	fmt.Fprintf(d.page(), "%.3f %.3f %.3f RG %.2f w %.2f %.2f m %.2f %.2f l S\n",
+		color.R, color.G, color.B, width, x1, d.Height-y1, x2, d.Height-y2)
+}
+
+// Rect fills a rectangle whose top-left corner is (x, y)
+func (d *Document) Rect(x, y, w, h float64, color Color) {
+	fmt.Fprintf(d.page(), "%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n",
+		color.R, color.G, color.B, x, d.Height-y-h, w, h)
+}
+
+// Bytes serializes the document
+func (d *Document) Bytes() ([]byte, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	w := &writer{}
+	w.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Fixed objects: 1 catalog, 2 page tree, 3-5 font, 6 info
+	const (
+		catalogID = 1
+		pagesID   = 2
+		fontID    = 3
+		cidFontID = 4
+		descID    = 5
+		infoID    = 6
+	)
+	next := 7
+
+	pageIDs := make([]int, len(d.pages))
+	for i := range d.pages {
+		pageIDs[i] = next
+		next += 2 // Page object followed by its content stream
+	}
+	imageIDs := make([]int, len(d.images))
+	for i := range d.images {
+		imageIDs[i] = next
+		next++
+	}
+
+	w.object(catalogID, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+
+	kids := make([]string, len(pageIDs))
+	for i, id := range pageIDs {
+		kids[i] = fmt.Sprintf("%d 0 R", id)
+	}
+	w.object(pagesID, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pageIDs)))
+
+	w.object(fontID, fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /STSong-Light /Encoding /UniGB-UCS2-H /DescendantFonts [%d 0 R] >>", cidFontID))
+	w.object(cidFontID, fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /STSong-Light "+
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (GB1) /Supplement 2 >> /FontDescriptor %d 0 R /DW 1000 /W %s >>",
+		descID, widthArray()))
+	w.object(descID, "<< /Type /FontDescriptor /FontName /STSong-Light /Flags 6 /FontBBox [-25 -254 1000 880] "+
+		"/ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>")
+	w.object(infoID, fmt.Sprintf("<< /Title <%s> /Author <%s> /Producer (ResuGo) >>",
+		encodeInfo(d.Title), encodeInfo(d.Author)))
+
+	xobjects := ""
+	for i, id := range imageIDs {
+		xobjects += fmt.Sprintf(" /Im%d %d 0 R", i+1, id)
+	}
+	if xobjects != "" {
+		xobjects = " /XObject <<" + xobjects + " >>"
+	}
+	resources := fmt.Sprintf("<< /Font << /F1 %d 0 R >>%s >>", fontID, xobjects)
+	for i, content := range d.pages {
+		w.object(pageIDs[i], fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources %s /Contents %d 0 R >>",
+			pagesID, d.Width, d.Height, resources, pageIDs[i]+1))
+		if err := w.stream(pageIDs[i]+1, "", content.Bytes(), true); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, img := range d.images {
+		extra := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /%s /BitsPerComponent 8",
+			img.Width, img.Height, img.colorSpace)
+		if img.filter != "" {
+			// Already compressed; stored as is
+			extra = "/Filter /" + img.filter + " " + extra
+		}
+		if err := w.stream(imageIDs[i], extra, img.data, img.filter == ""); err != nil {
+			return nil, err
+		}
+	}
+
+	w.finish(next, catalogID, infoID)
+	return w.buf.Bytes(), nil
+}
+
+// writer tracks object offsets for the cross-reference table
+type writer struct {
+	buf     bytes.Buffer
+	offsets map[int]int
+}
+
+func (w *writer) object(id int, body string) {
+	if w.offsets == nil {
+		w.offsets = make(map[int]int)
+	}
+	w.offsets[id] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", id, body)
+}
+
+// stream writes a stream object; extra holds additional dictionary entries
+func (w *writer) stream(id int, extra string, data []byte, compress bool) error {
+	filter := ""
+	if compress {
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		data = z.Bytes()
+		filter = " /Filter /FlateDecode"
+	}
+	if extra != "" {
+		extra = " " + extra
+	}
+	w.object(id, fmt.Sprintf("<< /Length %d%s%s >>\nstream\n%s\nendstream", len(data), filter, extra, data))
+	return nil
+}
+
+func (w *writer) finish(count, rootID, infoID int) {
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", count)
+	for id := 1; id < count; id++ {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", w.offsets[id])
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", count, rootID, infoID, xref)
+}
+
+// encodeText converts s to hex-encoded UCS-2 as expected by UniGB-UCS2-H.
+// Characters outside the Basic Multilingual Plane are replaced with "?".
+func encodeText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r > 0xFFFF {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
+
+// encodeInfo converts s to a hex-encoded UTF-16BE text string with BOM
+func encodeInfo(s string) string {
+	var b strings.Builder
+	b.WriteString("FEFF")
+	for _, unit := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", unit)
+	}
+	return b.String()
+}
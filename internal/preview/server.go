@@ -0,0 +1,239 @@
+package preview
+
+import (
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/generator"
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// reloadScript reconnects to the event stream and reloads the page on change
+const reloadScript = `<script>
+(function () {
+  var es = new EventSource("/events");
+  es.addEventListener("reload", function () { location.reload(); });
+})();
+</script>`
+
+// Server renders a resume in every theme and pushes reload events to
+// connected browsers whenever Reload is called
+type Server struct {
+	load         func() (*models.Resume, error)
+	newGenerator func(*models.Resume) *generator.Generator
+	logger       *slog.Logger
+
+	mu      sync.Mutex
+	clients map[chan struct{}]struct{}
+}
+
+// NewServer creates a preview server. load is called on every request so the
+// page always reflects the file on disk; newGenerator applies config options.
+func NewServer(load func() (*models.Resume, error), newGenerator func(*models.Resume) *generator.Generator, logger *slog.Logger) *Server {
+	return &Server{
+		load:         load,
+		newGenerator: newGenerator,
+		logger:       logger,
+		clients:      make(map[chan struct{}]struct{}),
+	}
+}
+
+// Handler returns the HTTP routes of the preview server
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleIndex)
+	mux.HandleFunc("GET /themes/{name}", s.handleTheme)
+	mux.HandleFunc("GET /download/{format}", s.handleDownload)
+	mux.HandleFunc("GET /events", s.handleEvents)
+	return mux
+}
+
+// Reload tells every connected browser to reload
+func (s *Server) Reload() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logger.Debug("broadcasting reload", "clients", len(s.clients))
+	for ch := range s.clients {
+		select {
+		case ch <- struct{}{}:
+		default: // A reload is already pending for this client
+		}
+	}
+}
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>ResuGo preview</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 0; background: #f0f2f5; }
+nav { background: #fff; padding: .8em 1.5em; box-shadow: 0 1px 3px rgba(0,0,0,.1); display: flex; gap: 2em; flex-wrap: wrap; }
+nav a { margin-right: .8em; }
+.error { background: #fdecea; color: #611a15; padding: 1em 1.5em; white-space: pre-wrap; margin: 1em 1.5em; }
+.grid { display: grid; grid-template-columns: repeat(auto-fill, minmax(520px, 1fr)); gap: 1.5em; padding: 1.5em; }
+.theme h3 { margin: 0 0 .4em; }
+iframe { width: 100%; height: 900px; border: 1px solid #ccc; background: #fff; }
+</style>
+</head>
+<body>
+<nav>
+<div><strong>Themes:</strong> {{range .Themes}}<a href="/themes/{{.}}">{{.}}</a>{{end}}</div>
+<div><strong>Download:</strong> {{range .Formats}}<a href="/download/{{.}}">{{.}}</a>{{end}}</div>
+</nav>
+{{if .Error}}<div class="error">{{.Error}}</div>{{end}}
+<div class="grid">
+{{range .Themes}}
+<div class="theme"><h3>{{.}}</h3><iframe src="/themes/{{.}}?embed=1"></iframe></div>
+{{end}}
+</div>
+{{.Script}}
+</body>
+</html>
+`))
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	data := struct {
+		Themes  []string
+		Formats []string
+		Error   string
+		Script  template.HTML
+	}{
+		Themes:  generator.Themes(),
+		Formats: generator.Formats,
+		Script:  template.HTML(reloadScript),
+	}
+	if _, err := s.load(); err != nil {
+		data.Error = err.Error()
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := indexTemplate.Execute(w, data); err != nil {
+		s.logger.Error("failed to render index", "error", err)
+	}
+}
+
+func (s *Server) handleTheme(w http.ResponseWriter, r *http.Request) {
+	theme := r.PathValue("name")
+	if !slices.Contains(generator.Themes(), theme) {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Embedded previews are reloaded by the index page itself
+	script := reloadScript
+	if r.URL.Query().Get("embed") != "" {
+		script = ""
+	}
+
+	resume, err := s.load()
+	if err != nil {
+		s.renderError(w, err, script)
+		return
+	}
+	page, err := s.newGenerator(resume).RenderHTML(theme, script)
+	if err != nil {
+		s.renderError(w, err, script)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(page)
+}
+
+func (s *Server) handleDownload(w http.ResponseWriter, r *http.Request) {
+	format := r.PathValue("format")
+	if !slices.Contains(generator.Formats, format) {
+		http.NotFound(w, r)
+		return
+	}
+
+	resume, err := s.load()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	theme := r.URL.Query().Get("theme")
+	data, err := s.newGenerator(resume).Render(format, theme)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	filename := "resume" + generator.Extension(format)
+	w.Header().Set("Content-Type", contentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Write(data)
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	ch := make(chan struct{}, 1)
+	s.mu.Lock()
+	s.clients[ch] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, ch)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	// Comments keep idle connections from being closed by proxies
+	heartbeat := time.NewTicker(30 * time.Second)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case <-ch:
+			fmt.Fprint(w, "event: reload\ndata: {}\n\n")
+		}
+		flusher.Flush()
+	}
+}
+
+// renderError shows a load or render error in place of the resume, keeping
+// the reload script so the page recovers once the file is fixed
+func (s *Server) renderError(w http.ResponseWriter, err error, script string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	fmt.Fprintf(w, "<!DOCTYPE html><html><head><meta charset=\"utf-8\"><title>Error</title></head>"+
+		"<body><pre style=\"color:#611a15;white-space:pre-wrap\">%s</pre>%s</body></html>",
+		template.HTMLEscapeString(err.Error()), script)
+}
+
+func contentType(format string) string {
+	switch strings.ToLower(format) {
+	case "html":
+		return "text/html; charset=utf-8"
+	case "markdown", "md":
+		return "text/markdown; charset=utf-8"
+	case "yaml":
+		return "application/yaml; charset=utf-8"
+	case "pdf":
+		return "application/pdf"
+	default:
+		return "application/octet-stream"
+	}
+}
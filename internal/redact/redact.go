@@ -0,0 +1,267 @@
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// Level selects how much identifying information is removed. Each level
+// includes everything removed by the levels before it.
+type Level string
+
+const (
+	// LevelContact masks personal details and links, and scrubs email
+	// addresses and URLs from free text
+	LevelContact Level = "contact"
+	// LevelEmployers also replaces company, institution and volunteer
+	// organization names with generic descriptors and drops entry locations
+	LevelEmployers Level = "employers"
+	// LevelFull also removes education dates, which reveal age
+	LevelFull Level = "full"
+)
+
+// Levels lists the levels from least to most redacted
+var Levels = []Level{LevelContact, LevelEmployers, LevelFull}
+
+// ParseLevel validates a level name
+func ParseLevel(s string) (Level, error) {
+	for _, level := range Levels {
+		if strings.EqualFold(s, string(level)) {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("unknown redaction level %q (valid: contact, employers, full)", s)
+}
+
+// includes reports whether l removes everything other removes
+func (l Level) includes(other Level) bool {
+	rank := func(level Level) int {
+		for i, candidate := range Levels {
+			if candidate == level {
+				return i
+			}
+		}
+		return -1
+	}
+	return rank(l) >= rank(other)
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[\w.+-]+@[\w-]+(\.[\w-]+)+`)
+	urlPattern   = regexp.MustCompile(`(?i)\b((https?://|www\.)\S+|(github|gitlab|linkedin)\.com/\S+)`)
+)
+
+// descriptors are the generic replacements, keyed by locale
+var descriptors = map[string]map[string]string{
+	"en": {"name": "Candidate", "company": "Company %s", "institution": "University %s", "organization": "Organization %s", "email": "[email]", "link": "[link]"},
+	"zh": {"name": "候选人", "company": "公司%s", "institution": "院校%s", "organization": "组织%s", "email": "[邮箱]", "link": "[链接]"},
+}
+
+// Resume returns a redacted copy of r for blind review; r is not modified
+func Resume(r *models.Resume, level Level, locale string) *models.Resume {
+	words, ok := descriptors[strings.ToLower(locale)]
+	if !ok {
+		words = descriptors["en"]
+	}
+	out := *r
+
+	// Names found in free text are replaced too, longest first so that
+	// "Acme Cloud" is not half-replaced by "Acme"
+	replacements := map[string]string{}
+	if name := strings.TrimSpace(r.PersonalInfo.Name); name != "" {
+		replacements[name] = words["name"]
+		// "Jane" or "Doe" alone give the candidate away as well
+		if parts := strings.Fields(name); len(parts) > 1 {
+			for _, part := range parts {
+				if len(part) > 2 {
+					replacements[part] = words["name"]
+				}
+			}
+		}
+	}
+
+	out.PersonalInfo = models.PersonalInfo{Name: words["name"], Title: r.PersonalInfo.Title}
+	out.Education = append([]models.Education(nil), r.Education...)
+	out.Experience = append([]models.Experience(nil), r.Experience...)
+	out.Projects = append([]models.Project(nil), r.Projects...)
+	out.Certifications = append([]models.Certification(nil), r.Certifications...)
+	out.Awards = append([]models.Award(nil), r.Awards...)
+	out.Publications = append([]models.Publication(nil), r.Publications...)
+	out.Volunteer = append([]models.Volunteer(nil), r.Volunteer...)
+	out.Additional = append([]models.Section(nil), r.Additional...)
+
+	for i := range out.Projects {
+		out.Projects[i].URL = ""
+		out.Projects[i].Repository = ""
+	}
+	// Credential IDs and DOIs lead straight to the candidate
+	for i := range out.Certifications {
+		out.Certifications[i].CredentialID = ""
+		out.Certifications[i].URL = ""
+	}
+	for i := range out.Publications {
+		out.Publications[i].DOI = ""
+		out.Publications[i].URL = ""
+	}
+
+	if level.includes(LevelEmployers) {
+		companies := descriptorsFor(r.Experience, func(e models.Experience) string { return e.Company }, words["company"])
+		institutions := descriptorsFor(r.Education, func(e models.Education) string { return e.Institution }, words["institution"])
+		for i := range out.Experience {
+			out.Experience[i].Company = companies[out.Experience[i].Company]
+			out.Experience[i].Location = ""
+		}
+		for i := range out.Education {
+			out.Education[i].Institution = institutions[out.Education[i].Institution]
+			out.Education[i].Location = ""
+		}
+		organizations := descriptorsFor(r.Volunteer, func(v models.Volunteer) string { return v.Organization }, words["organization"])
+		for i := range out.Volunteer {
+			out.Volunteer[i].Organization = organizations[out.Volunteer[i].Organization]
+			out.Volunteer[i].Location = ""
+		}
+		for i := range out.Projects {
+			out.Projects[i].Location = ""
+		}
+		for _, names := range []map[string]string{companies, institutions, organizations} {
+			for original, descriptor := range names {
+				replacements[original] = descriptor
+			}
+		}
+	}
+
+	if level.includes(LevelFull) {
+		for i := range out.Education {
+			out.Education[i].StartDate = time.Time{}
+			out.Education[i].EndDate = time.Time{}
+			out.Education[i].Current = false
+		}
+	}
+
+	s := newScrubber(replacements, words)
+	out.Summary = s.text(r.Summary)
+	for i := range out.Experience {
+		out.Experience[i].Responsibilities = s.list(out.Experience[i].Responsibilities)
+		out.Experience[i].Achievements = s.list(out.Experience[i].Achievements)
+		roles := append([]models.Role(nil), out.Experience[i].Roles...)
+		for j := range roles {
+			roles[j].Responsibilities = s.list(roles[j].Responsibilities)
+			roles[j].Achievements = s.list(roles[j].Achievements)
+		}
+		out.Experience[i].Roles = roles
+	}
+	for i := range out.Projects {
+		out.Projects[i].Description = s.text(out.Projects[i].Description)
+		out.Projects[i].Details = s.list(out.Projects[i].Details)
+	}
+	for i := range out.Education {
+		out.Education[i].Description = s.text(out.Education[i].Description)
+		out.Education[i].HonorsAwards = s.list(out.Education[i].HonorsAwards)
+	}
+	for i := range out.Awards {
+		out.Awards[i].Description = s.text(out.Awards[i].Description)
+	}
+	for i := range out.Publications {
+		out.Publications[i].Authors = s.list(out.Publications[i].Authors)
+		out.Publications[i].Title = s.text(out.Publications[i].Title)
+	}
+	for i := range out.Volunteer {
+		out.Volunteer[i].Details = s.list(out.Volunteer[i].Details)
+	}
+	for i := range out.Additional {
+		out.Additional[i].Items = s.list(out.Additional[i].Items)
+	}
+	return &out
+}
+
+// descriptorsFor maps each distinct name to a lettered descriptor in order
+// of first appearance
+func descriptorsFor[T any](entries []T, name func(T) string, format string) map[string]string {
+	result := make(map[string]string)
+	for _, entry := range entries {
+		n := name(entry)
+		if _, seen := result[n]; seen || strings.TrimSpace(n) == "" {
+			continue
+		}
+		result[n] = fmt.Sprintf(format, letters(len(result)))
+	}
+	return result
+}
+
+// letters returns A, B, ... Z, AA, AB, ... for i = 0, 1, ...
+func letters(i int) string {
+	s := ""
+	for i >= 0 {
+		s = string(rune('A'+i%26)) + s
+		i = i/26 - 1
+	}
+	return s
+}
+
+// scrubber removes identifying details from free text
+type scrubber struct {
+	names []nameReplacement // Longest name first
+	words map[string]string
+}
+
+// nameReplacement replaces whole-word occurrences of a name
+type nameReplacement struct {
+	pattern *regexp.Regexp
+	with    string
+}
+
+func newScrubber(replacements map[string]string, words map[string]string) *scrubber {
+	names := make([]string, 0, len(replacements))
+	for name := range replacements {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+
+	s := &scrubber{words: words}
+	for _, name := range names {
+		// Word boundaries only apply next to Latin letters and digits; CJK
+		// names are matched anywhere
+		expr := regexp.QuoteMeta(name)
+		if isWordByte(name[0]) {
+			expr = `\b` + expr
+		}
+		if isWordByte(name[len(name)-1]) {
+			expr += `\b`
+		}
+		s.names = append(s.names, nameReplacement{regexp.MustCompile(expr), replacements[name]})
+	}
+	return s
+}
+
+func (s *scrubber) text(text string) string {
+	text = emailPattern.ReplaceAllString(text, s.words["email"])
+	text = urlPattern.ReplaceAllStringFunc(text, func(url string) string {
+		// Keep sentence punctuation that follows the link
+		trimmed := strings.TrimRight(url, ".,;:!?)]}'\"")
+		return s.words["link"] + url[len(trimmed):]
+	})
+	for _, n := range s.names {
+		text = n.pattern.ReplaceAllLiteralString(text, n.with)
+	}
+	return text
+}
+
+func (s *scrubber) list(items []string) []string {
+	if items == nil {
+		return nil
+	}
+	result := make([]string, len(items))
+	for i, item := range items {
+		result[i] = s.text(item)
+	}
+	return result
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
@@ -0,0 +1,167 @@
+package resumefile
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Strategy decides how lists are combined when merging
+type Strategy string
+
+const (
+	Append     Strategy = "append"  // Lists are concatenated
+	Replace    Strategy = "replace" // Later lists replace earlier ones
+	MatchByKey Strategy = "key"     // Entries with the same key are merged, others appended
+)
+
+// entryKeys identifies list entries for MatchByKey, by path within the resume
+var entryKeys = map[string][]string{
+	"education":        {"institution", "degree"},
+	"experience":       {"company", "position"},
+	"experience.roles": {"position"},
+	"projects":         {"name"},
+	"certifications":   {"name"},
+	"awards":           {"title"},
+	"publications":     {"title"},
+	"volunteer":        {"organization", "role"},
+	"languages":        {"name"},
+	"additional":       {"title"},
+	"skills.custom":    {"name"},
+	// Skills are plain names or mappings with a level; see entryKey
+	"skills.languages":    {"name"},
+	"skills.frameworks":   {"name"},
+	"skills.databases":    {"name"},
+	"skills.tools":        {"name"},
+	"skills.other":        {"name"},
+	"skills.custom.items": {"name"},
+}
+
+// Merge deep-merges src into dst. Mappings are merged key by key, scalars
+// in src override those in dst and lists follow the strategy. With
+// MatchByKey, known entry lists such as experience are matched by their key
+// fields (see entryKeys) and plain string lists keep one copy of each item.
+func Merge(dst, src *yaml.Node, strategy Strategy) error {
+	switch strategy {
+	case Append, Replace, MatchByKey:
+	default:
+		return fmt.Errorf("unknown merge strategy %q (valid: append, replace, key)", strategy)
+	}
+	mergeNode(dst, src, strategy, "")
+	return nil
+}
+
+func mergeNode(dst, src *yaml.Node, strategy Strategy, path string) {
+	switch {
+	case src.Kind == yaml.ScalarNode && src.Tag == "!!null":
+		// An empty value leaves the existing one alone
+	case dst.Kind == yaml.MappingNode && src.Kind == yaml.MappingNode:
+		mergeMapping(dst, src, strategy, path)
+	case dst.Kind == yaml.SequenceNode && src.Kind == yaml.SequenceNode:
+		mergeSequence(dst, src, strategy, path)
+	default:
+		*dst = *src
+	}
+}
+
+func mergeMapping(dst, src *yaml.Node, strategy Strategy, path string) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		childPath := key.Value
+		if path != "" {
+			childPath = path + "." + key.Value
+		}
+		if existing := mappingValue(dst, key.Value); existing != nil {
+			mergeNode(existing, value, strategy, childPath)
+			continue
+		}
+		dst.Content = append(dst.Content, key, value)
+	}
+}
+
+func mergeSequence(dst, src *yaml.Node, strategy Strategy, path string) {
+	switch strategy {
+	case Replace:
+		*dst = *src
+		return
+	case Append:
+		dst.Content = append(dst.Content, src.Content...)
+		return
+	}
+
+	if fields, ok := entryKeys[path]; ok {
+		index := make(map[string]*yaml.Node)
+		for _, entry := range dst.Content {
+			if k := entryKey(entry, fields); k != "" {
+				index[k] = entry
+			}
+		}
+		for _, entry := range src.Content {
+			k := entryKey(entry, fields)
+			if existing := index[k]; k != "" && existing != nil {
+				// A bare name adds nothing to an entry already listed
+				if entry.Kind != yaml.ScalarNode {
+					mergeNode(existing, entry, strategy, path)
+				}
+				continue
+			}
+			dst.Content = append(dst.Content, entry)
+			if k != "" {
+				index[k] = entry
+			}
+		}
+		return
+	}
+
+	// Lists of plain values keep one copy of each item
+	seen := make(map[string]bool)
+	for _, item := range dst.Content {
+		if item.Kind == yaml.ScalarNode {
+			seen[item.Value] = true
+		}
+	}
+	for _, item := range src.Content {
+		if item.Kind == yaml.ScalarNode {
+			if seen[item.Value] {
+				continue
+			}
+			seen[item.Value] = true
+		}
+		dst.Content = append(dst.Content, item)
+	}
+}
+
+// mappingValue returns the value stored under key in a mapping node
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// entryKey builds a case-insensitive identity from the key fields of a
+// mapping entry, or "" when the entry has none of them
+func entryKey(entry *yaml.Node, fields []string) string {
+	if entry.Kind == yaml.ScalarNode && len(fields) == 1 {
+		// A plain string stands for an entry with only its key field set
+		return strings.ToLower(strings.TrimSpace(entry.Value))
+	}
+	if entry.Kind != yaml.MappingNode {
+		return ""
+	}
+	parts := make([]string, len(fields))
+	found := false
+	for i, field := range fields {
+		if value := mappingValue(entry, field); value != nil && value.Kind == yaml.ScalarNode {
+			parts[i] = strings.ToLower(strings.TrimSpace(value.Value))
+			found = found || parts[i] != ""
+		}
+	}
+	if !found {
+		return ""
+	}
+	return strings.Join(parts, "\x00")
+}
@@ -0,0 +1,201 @@
+package resumefile
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// IncludeTag marks a YAML value that is replaced by the contents of another
+// file, e.g. "projects: !include projects.yaml"
+const IncludeTag = "!include"
+
+// IncludesKey lists partial resume files that are merged under the file
+// containing it
+const IncludesKey = "includes"
+
+// Document is a resume assembled from a main file and everything it includes
+type Document struct {
+	Root  *yaml.Node // Mapping node with all includes resolved
+	Files []string   // Every file read, main file first
+}
+
+// Read parses path and resolves "!include" tags and "includes" lists
+// relative to the including file. Include cycles are reported as errors.
+func Read(path string) (*Document, error) {
+	r := &resolver{seen: make(map[string]bool)}
+	root, err := r.file(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s: a resume must be a YAML mapping", path)
+	}
+	return &Document{Root: root, Files: r.files}, nil
+}
+
+// Decode converts the document into a resume
+func (d *Document) Decode() (*models.Resume, error) {
+	var resume models.Resume
+	if err := d.Root.Decode(&resume); err != nil {
+		return nil, err
+	}
+	return &resume, nil
+}
+
+// Bytes renders the assembled document as a single YAML file
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(d.Root); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Load reads a resume file with its includes and decodes it
+func Load(path string) (*models.Resume, []string, error) {
+	doc, err := Read(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	resume, err := doc.Decode()
+	if err != nil {
+		return nil, doc.Files, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	return resume, doc.Files, nil
+}
+
+// resolver tracks the files read while assembling one document
+type resolver struct {
+	files []string
+	seen  map[string]bool
+}
+
+// file parses one file and resolves its includes. chain holds the files
+// currently being resolved, for cycle detection.
+func (r *resolver) file(path string, chain []string) (*yaml.Node, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range chain {
+		if p == abs {
+			cycle := append(append([]string{}, chain[i:]...), abs)
+			for j := range cycle {
+				cycle[j] = filepath.Base(cycle[j])
+			}
+			return nil, fmt.Errorf("include cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+	chain = append(chain, abs)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if len(chain) > 1 {
+			return nil, fmt.Errorf("failed to read include %s (from %s): %w", path, filepath.Base(chain[len(chain)-2]), err)
+		}
+		return nil, fmt.Errorf("failed to read input file: %w", err)
+	}
+	if !r.seen[abs] {
+		r.seen[abs] = true
+		r.files = append(r.files, path)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML in %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+	root := doc.Content[0]
+
+	dir := filepath.Dir(path)
+	if err := r.resolveTags(root, dir, chain); err != nil {
+		return nil, err
+	}
+	if root.Kind == yaml.MappingNode {
+		if err := r.resolveIncludes(root, dir, chain); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// resolvePath returns an include path relative to the including file's
+// directory; absolute paths are used as they are
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// resolveTags replaces every "!include" scalar below node with the root of
+// the named file
+func (r *resolver) resolveTags(node *yaml.Node, dir string, chain []string) error {
+	if node.Kind == yaml.ScalarNode && node.Tag == IncludeTag {
+		included, err := r.file(resolvePath(dir, node.Value), chain)
+		if err != nil {
+			return err
+		}
+		*node = *included
+		return nil
+	}
+	for _, child := range node.Content {
+		if err := r.resolveTags(child, dir, chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveIncludes merges the files listed under "includes" in order, then
+// the including file on top, so the including file wins on conflicts
+func (r *resolver) resolveIncludes(root *yaml.Node, dir string, chain []string) error {
+	var list *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == IncludesKey {
+			list = root.Content[i+1]
+			root.Content = append(root.Content[:i:i], root.Content[i+2:]...)
+			break
+		}
+	}
+	if list == nil {
+		return nil
+	}
+
+	var paths []string
+	if err := list.Decode(&paths); err != nil {
+		return fmt.Errorf("%s must be a list of file names: %w", IncludesKey, err)
+	}
+	base := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, p := range paths {
+		included, err := r.file(resolvePath(dir, p), chain)
+		if err != nil {
+			return err
+		}
+		if included.Kind != yaml.MappingNode {
+			return fmt.Errorf("included file %s must be a YAML mapping", p)
+		}
+		if err := Merge(base, included, MatchByKey); err != nil {
+			return fmt.Errorf("failed to merge %s: %w", p, err)
+		}
+	}
+	own := &yaml.Node{Kind: yaml.MappingNode, Tag: root.Tag, Content: root.Content}
+	if err := Merge(base, own, MatchByKey); err != nil {
+		return err
+	}
+	root.Content = base.Content
+	return nil
+}
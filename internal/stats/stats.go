@@ -0,0 +1,254 @@
+package stats
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/textutil"
+	"github.com/loveRyujin/ResuGo/internal/timeline"
+)
+
+// Report summarizes the size and coverage of a resume
+type Report struct {
+	ExperienceMonths   int              `json:"experience_months"`
+	ExperienceYears    float64          `json:"experience_years"`
+	Technologies       []TechnologyStat `json:"technologies"`
+	Roles              []RoleStat       `json:"roles"`
+	Sections           []SectionStat    `json:"sections"`
+	Bullets            int              `json:"bullets"`
+	AverageBulletWords float64          `json:"average_bullet_words"`
+}
+
+// TechnologyStat is the time spent with one technology
+type TechnologyStat struct {
+	Name    string  `json:"name"`
+	Months  int     `json:"months"`
+	Years   float64 `json:"years"`
+	Entries int     `json:"entries"` // Roles and projects tagged with it
+}
+
+// RoleStat describes one experience entry
+type RoleStat struct {
+	Company  string `json:"company"`
+	Position string `json:"position"`
+	Months   int    `json:"months"`
+	Bullets  int    `json:"bullets"`
+}
+
+// SectionStat is the word count of one section
+type SectionStat struct {
+	Section string `json:"section"`
+	Words   int    `json:"words"`
+}
+
+// Options configures the report
+type Options struct {
+	Now time.Time // End date of current entries; zero means time.Now()
+}
+
+// interval is a resolved date range
+type interval struct {
+	start, end time.Time
+}
+
+// Compute builds the statistics report for a resume. Word counts treat each
+// CJK character as one word.
+func Compute(r *models.Resume, opts Options) Report {
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var report Report
+	var jobs []interval
+	techs := make(map[string]*techUsage)
+	var order []string
+	tag := func(names []string, iv interval, ok bool) {
+		for _, name := range names {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			key := strings.ToLower(name)
+			usage, seen := techs[key]
+			if !seen {
+				usage = &techUsage{name: name}
+				techs[key] = usage
+				order = append(order, key)
+			}
+			usage.entries++
+			if ok {
+				usage.intervals = append(usage.intervals, iv)
+			}
+		}
+	}
+
+	for _, exp := range r.Experience {
+		start, end, current := exp.Tenure()
+		iv, ok := resolve(start, end, current, opts.Now)
+		if ok {
+			jobs = append(jobs, iv)
+		}
+		tag(exp.Technologies, iv, ok)
+		for _, position := range exp.Positions() {
+			role := RoleStat{
+				Company:  exp.Company,
+				Position: position.Position,
+				Bullets:  len(position.Responsibilities) + len(position.Achievements),
+			}
+			if iv, ok := resolve(position.StartDate, position.EndDate, position.Current, opts.Now); ok {
+				role.Months = timeline.Months(iv.start, iv.end)
+			}
+			report.Roles = append(report.Roles, role)
+		}
+	}
+	for _, proj := range r.Projects {
+		iv, ok := resolve(proj.StartDate, proj.EndDate, proj.Current, opts.Now)
+		tag(proj.Technologies, iv, ok)
+	}
+
+	report.ExperienceMonths = mergedMonths(jobs)
+	report.ExperienceYears = years(report.ExperienceMonths)
+
+	for _, key := range order {
+		usage := techs[key]
+		months := mergedMonths(usage.intervals)
+		report.Technologies = append(report.Technologies, TechnologyStat{
+			Name:    usage.name,
+			Months:  months,
+			Years:   years(months),
+			Entries: usage.entries,
+		})
+	}
+	sort.SliceStable(report.Technologies, func(i, j int) bool {
+		return report.Technologies[i].Months > report.Technologies[j].Months
+	})
+
+	report.Sections = sectionWords(r)
+
+	totalWords := 0
+	count := func(bullets []string) {
+		for _, text := range bullets {
+			report.Bullets++
+			totalWords += textutil.WordCount(text)
+		}
+	}
+	for _, exp := range r.Experience {
+		count(exp.AllResponsibilities())
+		count(exp.AllAchievements())
+	}
+	for _, proj := range r.Projects {
+		count(proj.Details)
+	}
+	if report.Bullets > 0 {
+		report.AverageBulletWords = round1(float64(totalWords) / float64(report.Bullets))
+	}
+	return report
+}
+
+// techUsage collects the dated entries tagged with one technology
+type techUsage struct {
+	name      string // Spelling of the first occurrence
+	entries   int
+	intervals []interval
+}
+
+// resolve turns entry dates into an interval, reporting false for undated
+// or impossible ranges
+func resolve(start, end time.Time, current bool, now time.Time) (interval, bool) {
+	if current {
+		end = now
+	}
+	if start.IsZero() || end.IsZero() || end.Before(start) {
+		return interval{}, false
+	}
+	return interval{start, end}, true
+}
+
+// mergedMonths returns the number of months covered by the intervals,
+// counting overlapping time once
+func mergedMonths(intervals []interval) int {
+	if len(intervals) == 0 {
+		return 0
+	}
+	sorted := append([]interval{}, intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].start.Before(sorted[j].start) })
+
+	months := 0
+	cur := sorted[0]
+	for _, iv := range sorted[1:] {
+		if iv.start.After(cur.end) {
+			months += timeline.Months(cur.start, cur.end)
+			cur = iv
+			continue
+		}
+		if iv.end.After(cur.end) {
+			cur.end = iv.end
+		}
+	}
+	return months + timeline.Months(cur.start, cur.end)
+}
+
+// sectionWords counts words in every non-empty section in default order
+func sectionWords(r *models.Resume) []SectionStat {
+	texts := map[string][]string{models.SectionSummary: {r.Summary}}
+	for _, edu := range r.Education {
+		texts[models.SectionEducation] = append(texts[models.SectionEducation], edu.Institution, edu.Degree, edu.Major, edu.Description)
+		texts[models.SectionEducation] = append(texts[models.SectionEducation], edu.RelevantCourses...)
+		texts[models.SectionEducation] = append(texts[models.SectionEducation], edu.HonorsAwards...)
+	}
+	for _, exp := range r.Experience {
+		texts[models.SectionExperience] = append(texts[models.SectionExperience], exp.Company)
+		for _, role := range exp.Positions() {
+			texts[models.SectionExperience] = append(texts[models.SectionExperience], role.Position)
+		}
+		texts[models.SectionExperience] = append(texts[models.SectionExperience], exp.AllResponsibilities()...)
+		texts[models.SectionExperience] = append(texts[models.SectionExperience], exp.AllAchievements()...)
+	}
+	for _, proj := range r.Projects {
+		texts[models.SectionProjects] = append(texts[models.SectionProjects], proj.Name, proj.Description)
+		texts[models.SectionProjects] = append(texts[models.SectionProjects], proj.Details...)
+	}
+	texts[models.SectionSkills] = r.Skills.All().Names()
+	for _, cert := range r.Certifications {
+		texts[models.SectionCertifications] = append(texts[models.SectionCertifications], cert.Name, cert.Issuer)
+	}
+	for _, award := range r.Awards {
+		texts[models.SectionAwards] = append(texts[models.SectionAwards], award.Title, award.Issuer, award.Description)
+	}
+	for _, pub := range r.Publications {
+		texts[models.SectionPublications] = append(texts[models.SectionPublications], pub.Title, pub.Venue)
+	}
+	for _, v := range r.Volunteer {
+		texts[models.SectionVolunteer] = append(texts[models.SectionVolunteer], v.Organization, v.Role)
+		texts[models.SectionVolunteer] = append(texts[models.SectionVolunteer], v.Details...)
+	}
+	for _, lang := range r.Languages {
+		texts[models.SectionLanguages] = append(texts[models.SectionLanguages], lang.Name, lang.Level, lang.Certification)
+	}
+	for _, section := range r.Additional {
+		texts[models.SectionAdditional] = append(texts[models.SectionAdditional], section.Title)
+		texts[models.SectionAdditional] = append(texts[models.SectionAdditional], section.Items...)
+	}
+
+	var result []SectionStat
+	for _, key := range models.DefaultSectionOrder {
+		words := 0
+		for _, text := range texts[key] {
+			words += textutil.WordCount(text)
+		}
+		if words > 0 {
+			result = append(result, SectionStat{Section: key, Words: words})
+		}
+	}
+	return result
+}
+
+func years(months int) float64 {
+	return round1(float64(months) / 12)
+}
+
+func round1(f float64) float64 {
+	return float64(int(f*10+0.5)) / 10
+}
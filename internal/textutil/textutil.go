@@ -0,0 +1,36 @@
+package textutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+// IsCJK reports whether r is a Chinese, Japanese or Korean character
+func IsCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// CJKCount returns the number of CJK characters in s
+func CJKCount(s string) int {
+	n := 0
+	for _, r := range s {
+		if IsCJK(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// LatinWords splits s into words made of letters, digits and apostrophes,
+// ignoring CJK text
+func LatinWords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return IsCJK(r) || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' || r == '’')
+	})
+}
+
+// WordCount counts English words plus CJK characters, the usual measure of
+// length for mixed Chinese and English text
+func WordCount(s string) int {
+	return len(LatinWords(s)) + CJKCount(s)
+}
@@ -0,0 +1,227 @@
+package timeline
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+)
+
+// Kinds of timeline warnings
+const (
+	KindEndBeforeStart  = "end-before-start"
+	KindFutureStart     = "future-start"
+	KindMultipleCurrent = "multiple-current"
+	KindOverlap         = "overlap"
+	KindGap             = "gap"
+)
+
+// Warning describes one timeline inconsistency
+type Warning struct {
+	Kind    string `json:"kind"`
+	Section string `json:"section"` // experience, education or projects
+	Subject string `json:"subject"` // Entry the warning is about
+	Other   string `json:"other,omitempty"`
+	Months  int    `json:"months,omitempty"` // Gap or overlap length
+}
+
+// Message returns an English description of the warning
+func (w Warning) Message() string {
+	switch w.Kind {
+	case KindEndBeforeStart:
+		return fmt.Sprintf("%s %q ends before it starts", w.Section, w.Subject)
+	case KindFutureStart:
+		return fmt.Sprintf("%s %q starts in the future", w.Section, w.Subject)
+	case KindMultipleCurrent:
+		return fmt.Sprintf("%q and %q are both marked as current jobs", w.Other, w.Subject)
+	case KindOverlap:
+		return fmt.Sprintf("full-time roles %q and %q overlap by %d month(s)", w.Other, w.Subject, w.Months)
+	case KindGap:
+		return fmt.Sprintf("%d-month employment gap between %q and %q", w.Months, w.Other, w.Subject)
+	}
+	return w.Kind
+}
+
+// MessageZH returns a Chinese description of the warning
+func (w Warning) MessageZH() string {
+	sections := map[string]string{"experience": "工作经历", "education": "教育经历", "projects": "项目"}
+	section := sections[w.Section]
+	switch w.Kind {
+	case KindEndBeforeStart:
+		return fmt.Sprintf("%s「%s」的结束时间早于开始时间", section, w.Subject)
+	case KindFutureStart:
+		return fmt.Sprintf("%s「%s」的开始时间在未来", section, w.Subject)
+	case KindMultipleCurrent:
+		return fmt.Sprintf("「%s」和「%s」都标记为当前工作", w.Other, w.Subject)
+	case KindOverlap:
+		return fmt.Sprintf("全职工作「%s」与「%s」重叠 %d 个月", w.Other, w.Subject, w.Months)
+	case KindGap:
+		return fmt.Sprintf("「%s」与「%s」之间有 %d 个月的空档期", w.Other, w.Subject, w.Months)
+	}
+	return w.Kind
+}
+
+// DefaultGapMonths is the gap threshold used when Options.GapMonths is nil
+const DefaultGapMonths = 6
+
+// Options configures the checks
+type Options struct {
+	GapMonths *int      // Gaps longer than this are reported; nil means DefaultGapMonths
+	Now       time.Time // Reference time for "current" and "future"; zero means time.Now()
+}
+
+// period is a dated entry of any section
+type period struct {
+	section string
+	name    string
+	start   time.Time
+	end     time.Time
+	current bool
+}
+
+// Check analyzes experience, education and projects for impossible or
+// suspicious dates
+func Check(r *models.Resume, opts Options) []Warning {
+	if opts.GapMonths == nil {
+		gapMonths := DefaultGapMonths
+		opts.GapMonths = &gapMonths
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var jobs, all []period
+	for _, exp := range r.Experience {
+		start, end, current := exp.Tenure()
+		p := period{"experience", experienceName(exp), start, end, current}
+		if exp.HasRoles() {
+			// Check each role's own dates; the tenure is derived from them
+			for _, role := range exp.Roles {
+				all = append(all, period{"experience", exp.Company + " / " + role.Position, role.StartDate, role.EndDate, role.Current})
+			}
+		} else {
+			all = append(all, p)
+		}
+		if exp.IsFullTime() {
+			jobs = append(jobs, p)
+		}
+	}
+	var studies []period
+	for _, edu := range r.Education {
+		p := period{"education", edu.Institution, edu.StartDate, edu.EndDate, edu.Current}
+		all = append(all, p)
+		studies = append(studies, p)
+	}
+	for _, proj := range r.Projects {
+		all = append(all, period{"projects", proj.Name, proj.StartDate, proj.EndDate, proj.Current})
+	}
+
+	var warnings []Warning
+	for _, p := range all {
+		if p.start.IsZero() {
+			continue
+		}
+		if !p.current && !p.end.IsZero() && p.end.Before(p.start) {
+			warnings = append(warnings, Warning{Kind: KindEndBeforeStart, Section: p.section, Subject: p.name})
+		}
+		if p.start.After(opts.Now) {
+			warnings = append(warnings, Warning{Kind: KindFutureStart, Section: p.section, Subject: p.name})
+		}
+	}
+
+	var firstCurrent string
+	for _, exp := range r.Experience {
+		if _, _, current := exp.Tenure(); !current {
+			continue
+		}
+		if firstCurrent == "" {
+			firstCurrent = experienceName(exp)
+			continue
+		}
+		warnings = append(warnings, Warning{Kind: KindMultipleCurrent, Section: "experience", Subject: experienceName(exp), Other: firstCurrent})
+	}
+
+	warnings = append(warnings, checkJobs(jobs, studies, opts)...)
+	return warnings
+}
+
+// checkJobs reports overlapping full-time roles and gaps between them that
+// are not covered by education
+func checkJobs(jobs, studies []period, opts Options) []Warning {
+	jobs = usable(jobs, opts.Now)
+	studies = usable(studies, opts.Now)
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].start.Before(jobs[j].start) })
+	sort.SliceStable(studies, func(i, j int) bool { return studies[i].start.Before(studies[j].start) })
+
+	var warnings []Warning
+	for i := 1; i < len(jobs); i++ {
+		cur := jobs[i]
+
+		for _, prev := range jobs[:i] {
+			if overlap := Months(cur.start, minTime(prev.end, cur.end)); overlap >= 1 {
+				warnings = append(warnings, Warning{Kind: KindOverlap, Section: "experience", Subject: cur.name, Other: prev.name, Months: overlap})
+			}
+		}
+
+		// The gap starts when the latest earlier role ended
+		last := jobs[0]
+		for _, prev := range jobs[1:i] {
+			if prev.end.After(last.end) {
+				last = prev
+			}
+		}
+		gapStart := last.end
+		// Studying fills the gap, e.g. a master's degree between two jobs
+		for _, s := range studies {
+			if !s.start.After(gapStart) && s.end.After(gapStart) {
+				gapStart = s.end
+			}
+		}
+		if gap := Months(gapStart, cur.start); gap > *opts.GapMonths {
+			warnings = append(warnings, Warning{Kind: KindGap, Section: "experience", Subject: cur.name, Other: last.name, Months: gap})
+		}
+	}
+	return warnings
+}
+
+// usable drops undated or impossible periods and resolves current end dates
+func usable(periods []period, now time.Time) []period {
+	var result []period
+	for _, p := range periods {
+		if p.current {
+			p.end = now
+		}
+		if p.start.IsZero() || p.end.IsZero() || p.end.Before(p.start) {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// Months returns the whole number of months from a to b, or 0 if b is not after a
+func Months(a, b time.Time) int {
+	if !b.After(a) {
+		return 0
+	}
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	if b.Day() < a.Day() {
+		months--
+	}
+	return max(months, 0)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func experienceName(exp models.Experience) string {
+	if exp.Title() == "" {
+		return exp.Company
+	}
+	return exp.Company + " / " + exp.Title()
+}
@@ -4,11 +4,15 @@ import (
 	"log"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/loveRyujin/ResuGo/internal/timeline"
 )
 
-// StartCreateResume starts the interactive resume creation interface
-func StartCreateResume() error {
-	p := tea.NewProgram(NewModel())
+// StartCreateResume starts the interactive resume creation interface.
+// checks configures the timeline warnings on the confirmation screen.
+func StartCreateResume(checks timeline.Options) error {
+	m := NewModel()
+	m.timelineOptions = checks
+	p := tea.NewProgram(m)
 
 	if _, err := p.Run(); err != nil {
 		log.Fatal(err)
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -25,20 +26,64 @@ func (m *Model) saveCurrentStep() {
 		m.saveProjects()
 	case StepSkills:
 		m.saveSkills()
+	case StepCertifications:
+		m.saveCertifications()
+	case StepAwards:
+		m.saveAwards()
+	case StepPublications:
+		m.savePublications()
+	case StepVolunteer:
+		m.saveVolunteer()
+	case StepLanguages:
+		m.saveLanguages()
 	case StepCustomSections:
 		m.saveCustomSections()
 	}
 }
 
-// savePersonalInfo saves personal information data
+// savePersonalInfo saves personal information data. Link icons are not
+// editable in the form and are kept for links whose URL is unchanged.
 func (m *Model) savePersonalInfo() {
+	icons := make(map[string]string)
+	for _, link := range m.resume.PersonalInfo.Links {
+		icons[link.URL] = link.Icon
+	}
+	var links []models.Link
+	for _, item := range parseSkillList(m.fields[8].Value) {
+		link := parseLink(item)
+		link.Icon = icons[link.URL]
+		links = append(links, link)
+	}
+
 	m.resume.PersonalInfo = models.PersonalInfo{
 		Name:     strings.TrimSpace(m.fields[0].Value),
-		Email:    strings.TrimSpace(m.fields[1].Value),
-		Phone:    strings.TrimSpace(m.fields[2].Value),
-		Location: strings.TrimSpace(m.fields[3].Value),
-		Website:  strings.TrimSpace(m.fields[4].Value),
+		Title:    strings.TrimSpace(m.fields[1].Value),
+		Email:    strings.TrimSpace(m.fields[2].Value),
+		Phone:    strings.TrimSpace(m.fields[3].Value),
+		Location: strings.TrimSpace(m.fields[4].Value),
+		Website:  strings.TrimSpace(m.fields[5].Value),
+		GitHub:   strings.TrimSpace(m.fields[6].Value),
+		LinkedIn: strings.TrimSpace(m.fields[7].Value),
+		Links:    links,
+		Photo:    strings.TrimSpace(m.fields[9].Value),
+	}
+}
+
+// formatLink formats a link as a list item, "label | url"
+func formatLink(link models.Link) string {
+	if link.Label == "" {
+		return link.URL
 	}
+	return link.Label + " | " + link.URL
+}
+
+// parseLink parses a list item written as "label | url" or just "url"
+func parseLink(item string) models.Link {
+	label, url, ok := strings.Cut(item, "|")
+	if !ok {
+		return models.Link{URL: strings.TrimSpace(item)}
+	}
+	return models.Link{Label: strings.TrimSpace(label), URL: strings.TrimSpace(url)}
 }
 
 // saveSummary saves summary data
@@ -91,67 +136,139 @@ func (m *Model) saveExperience() {
 	var endDate time.Time
 	var current bool
 
+	// An ongoing entry keeps a zero end date, as in hand-written YAML
 	if m.fields[4].Value == "current" {
 		current = true
-		endDate = time.Now()
 	} else {
 		endDate, _ = time.Parse("2006-01", m.fields[4].Value)
 	}
 
-	// Split responsibilities by newlines and filter empty ones
-	responsibilities := []string{}
-	for _, line := range strings.Split(strings.TrimSpace(m.fields[5].Value), "\n") {
-		if trimmed := strings.TrimSpace(line); trimmed != "" {
-			responsibilities = append(responsibilities, trimmed)
+	responsibilities := splitLines(m.fields[5].Value)
+	achievements := splitLines(m.fields[6].Value)
+
+	if m.addingRole || m.editingRole >= 0 {
+		role := models.Role{
+			Position:         strings.TrimSpace(m.fields[1].Value),
+			StartDate:        startDate,
+			EndDate:          endDate,
+			Current:          current,
+			Responsibilities: responsibilities,
+			Achievements:     achievements,
 		}
+		m.saveRole(role)
+		return
 	}
 
-	exp := models.Experience{
-		Company:          strings.TrimSpace(m.fields[0].Value),
-		Position:         strings.TrimSpace(m.fields[1].Value),
-		Location:         strings.TrimSpace(m.fields[2].Value),
-		StartDate:        startDate,
-		EndDate:          endDate,
-		Current:          current,
-		Responsibilities: responsibilities,
+	// Edits merge into the existing experience, so fields the form does not
+	// show (type, technologies, priority) are kept
+	var exp models.Experience
+	index := m.editingExperience
+	if index >= 0 && index < len(m.resume.Experience) {
+		exp = m.resume.Experience[index]
 	}
+	exp.Company = strings.TrimSpace(m.fields[0].Value)
+	exp.Position = strings.TrimSpace(m.fields[1].Value)
+	exp.Location = strings.TrimSpace(m.fields[2].Value)
+	exp.StartDate = startDate
+	exp.EndDate = endDate
+	exp.Current = current
+	exp.Responsibilities = responsibilities
+	exp.Achievements = achievements
 
 	// Update existing experience or add new one based on editingExperience index
-	if m.editingExperience >= 0 && m.editingExperience < len(m.resume.Experience) {
-		// Update existing experience
-		m.resume.Experience[m.editingExperience] = exp
+	if index >= 0 && index < len(m.resume.Experience) {
+		m.resume.Experience[index] = exp
 	} else {
-		// Add new experience entry
 		m.resume.Experience = append(m.resume.Experience, exp)
+		index = len(m.resume.Experience) - 1
 	}
 
-	// Return to experience management using dedicated method
-	wasEditing := m.editingExperience >= 0
-	editIndex := m.editingExperience
-	m.returnToExperienceManagement(wasEditing, editIndex)
+	m.returnToExperienceManagement(index, -1)
+}
+
+// splitLines splits a multiline field into its non-empty, trimmed lines
+func splitLines(value string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimSpace(value), "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			lines = append(lines, trimmed)
+		}
+	}
+	return lines
+}
+
+// saveRole stores role under the experience being edited. Adding a role to
+// an experience without roles first turns its own position into a role.
+// Roles are kept most recent first.
+func (m *Model) saveRole(role models.Role) {
+	index := m.editingExperience
+	exp := &m.resume.Experience[index]
+	exp.Company = strings.TrimSpace(m.fields[0].Value)
+	exp.Location = strings.TrimSpace(m.fields[2].Value)
+
+	if m.addingRole {
+		if !exp.HasRoles() {
+			exp.Roles = exp.Positions()
+			exp.Position, exp.StartDate, exp.EndDate, exp.Current = "", time.Time{}, time.Time{}, false
+			exp.Responsibilities, exp.Achievements = nil, nil
+		}
+		exp.Roles = append(exp.Roles, role)
+	} else if m.editingRole < len(exp.Roles) {
+		exp.Roles[m.editingRole] = role
+	}
+
+	sort.SliceStable(exp.Roles, func(i, j int) bool {
+		return exp.Roles[i].StartDate.After(exp.Roles[j].StartDate)
+	})
+	roleIndex := 0
+	for i := range exp.Roles {
+		if exp.Roles[i].Position == role.Position && exp.Roles[i].StartDate.Equal(role.StartDate) {
+			roleIndex = i
+			break
+		}
+	}
+	m.returnToExperienceManagement(index, roleIndex)
+}
+
+// experienceRow is one selectable line of the experience management list:
+// an experience without roles, or one role of an experience with several
+type experienceRow struct {
+	exp  int
+	role int // -1 for an experience without roles
+}
+
+// experienceRows lists the selectable lines of the experience management list
+func (m Model) experienceRows() []experienceRow {
+	var rows []experienceRow
+	for i, exp := range m.resume.Experience {
+		if !exp.HasRoles() {
+			rows = append(rows, experienceRow{exp: i, role: -1})
+			continue
+		}
+		for j := range exp.Roles {
+			rows = append(rows, experienceRow{exp: i, role: j})
+		}
+	}
+	return rows
 }
 
-// returnToExperienceManagement returns to experience management mode with proper state
-func (m *Model) returnToExperienceManagement(wasEditing bool, editIndex int) {
+// returnToExperienceManagement returns to experience management mode with
+// the given experience (and role, or -1) selected
+func (m *Model) returnToExperienceManagement(index, role int) {
 	// Set management mode states
 	m.managingExperiences = true
 	m.editingExperience = -1
+	m.editingRole = -1
+	m.addingRole = false
 	m.fields = nil
 
-	// Set proper selection
-	if wasEditing {
-		m.selectedExperience = editIndex
-	} else {
-		// Select the newly added item (last in list)
-		m.selectedExperience = len(m.resume.Experience) - 1
-	}
-
-	// Ensure selection is valid
-	if m.selectedExperience >= len(m.resume.Experience) {
-		m.selectedExperience = len(m.resume.Experience) - 1
-	}
-	if m.selectedExperience < 0 {
-		m.selectedExperience = 0
+	// Select the saved row
+	m.selectedExperience = 0
+	for i, row := range m.experienceRows() {
+		if row.exp == index && (row.role == role || role < 0) {
+			m.selectedExperience = i
+			break
+		}
 	}
 
 	// Clear any form-related states
@@ -165,13 +282,12 @@ func (m *Model) cancelExperienceEdit() {
 	// Set management mode states
 	m.managingExperiences = true
 	m.editingExperience = -1
+	m.editingRole = -1
+	m.addingRole = false
 	m.fields = nil
 
 	// Keep current selection or reset to 0 if invalid
-	if m.selectedExperience >= len(m.resume.Experience) {
-		m.selectedExperience = 0
-	}
-	if m.selectedExperience < 0 && len(m.resume.Experience) > 0 {
+	if m.selectedExperience >= len(m.experienceRows()) || m.selectedExperience < 0 {
 		m.selectedExperience = 0
 	}
 
@@ -187,30 +303,29 @@ func (m *Model) saveProjects() {
 	var endDate time.Time
 	var current bool
 
+	// An ongoing entry keeps a zero end date, as in hand-written YAML
 	if m.fields[4].Value == "current" {
 		current = true
-		endDate = time.Now()
 	} else {
 		endDate, _ = time.Parse("2006-01", m.fields[4].Value)
 	}
 
-	// Split details by newlines and filter empty ones
-	details := []string{}
-	for _, line := range strings.Split(strings.TrimSpace(m.fields[5].Value), "\n") {
-		if trimmed := strings.TrimSpace(line); trimmed != "" {
-			details = append(details, trimmed)
-		}
-	}
-
-	project := models.Project{
-		Name:        strings.TrimSpace(m.fields[0].Value),
-		Description: strings.TrimSpace(m.fields[1].Value),
-		Location:    strings.TrimSpace(m.fields[2].Value),
-		StartDate:   startDate,
-		EndDate:     endDate,
-		Current:     current,
-		Details:     details,
-	}
+	// Edits merge into the existing project, so fields the form does not
+	// show (priority) are kept
+	var project models.Project
+	if m.editingProject >= 0 && m.editingProject < len(m.resume.Projects) {
+		project = m.resume.Projects[m.editingProject]
+	}
+	project.Name = strings.TrimSpace(m.fields[0].Value)
+	project.Description = strings.TrimSpace(m.fields[1].Value)
+	project.Location = strings.TrimSpace(m.fields[2].Value)
+	project.StartDate = startDate
+	project.EndDate = endDate
+	project.Current = current
+	project.Details = splitLines(m.fields[5].Value)
+	project.Technologies = m.fields[6].items()
+	project.URL = strings.TrimSpace(m.fields[7].Value)
+	project.Repository = strings.TrimSpace(m.fields[8].Value)
 
 	// Update existing project or add new one based on editingProject index
 	if m.editingProject >= 0 && m.editingProject < len(m.resume.Projects) {
@@ -279,50 +394,225 @@ func (m *Model) cancelProjectEdit() {
 
 // saveSkills saves skills data
 func (m *Model) saveSkills() {
-	m.resume.Skills = models.Skills{
-		Languages:  parseSkillList(m.fields[0].Value),
-		Frameworks: parseSkillList(m.fields[1].Value),
-		Databases:  parseSkillList(m.fields[2].Value),
-		Other:      parseSkillList(m.fields[3].Value),
+	// Items were checked by validateCurrentStep
+	var skills models.Skills
+	for i, list := range []*models.SkillList{&skills.Languages, &skills.Frameworks, &skills.Databases, &skills.Tools, &skills.Other} {
+		*list, _ = parseSkills(m.fields[i].items())
+	}
+	for _, item := range m.fields[5].items() {
+		category, _ := parseSkillCategory(item)
+		skills.Custom = append(skills.Custom, category)
 	}
+	m.resume.Skills = skills
 }
 
-// saveCustomSections saves custom sections data
-func (m *Model) saveCustomSections() {
-	title := strings.TrimSpace(m.fields[0].Value)
-	if title != "" {
-		items := parseSkillList(m.fields[1].Value)
-		if len(items) > 0 {
-			section := models.Section{
-				Title: title,
-				Items: items,
-			}
-			m.resume.Additional = append(m.resume.Additional, section)
+// saveCertifications saves certifications, keeping verification links of
+// certifications that are still listed
+func (m *Model) saveCertifications() {
+	urls := make(map[string]string)
+	for _, cert := range m.resume.Certifications {
+		urls[cert.Name] = cert.URL
+	}
+	var certs []models.Certification
+	for _, item := range m.fields[0].items() {
+		cert, _ := parseCertification(item) // Checked by validateCurrentStep
+		cert.URL = urls[cert.Name]
+		certs = append(certs, cert)
+	}
+	m.resume.Certifications = certs
+}
+
+// saveAwards saves awards
+func (m *Model) saveAwards() {
+	var awards []models.Award
+	for _, item := range m.fields[0].items() {
+		award, _ := parseAward(item)
+		awards = append(awards, award)
+	}
+	m.resume.Awards = awards
+}
+
+// savePublications saves publications, keeping links of publications that
+// are still listed
+func (m *Model) savePublications() {
+	urls := make(map[string]string)
+	for _, pub := range m.resume.Publications {
+		urls[pub.Title] = pub.URL
+	}
+	var pubs []models.Publication
+	for _, item := range m.fields[0].items() {
+		pub, _ := parsePublication(item)
+		pub.URL = urls[pub.Title]
+		pubs = append(pubs, pub)
+	}
+	m.resume.Publications = pubs
+}
+
+// saveVolunteer saves volunteer roles, keeping the location and details of
+// roles that are still listed
+func (m *Model) saveVolunteer() {
+	existing := make(map[string]models.Volunteer)
+	for _, v := range m.resume.Volunteer {
+		existing[v.Organization+"|"+v.Role] = v
+	}
+	var roles []models.Volunteer
+	for _, item := range m.fields[0].items() {
+		v, _ := parseVolunteer(item)
+		if old, ok := existing[v.Organization+"|"+v.Role]; ok {
+			v.Location, v.Details = old.Location, old.Details
 		}
+		roles = append(roles, v)
+	}
+	m.resume.Volunteer = roles
+}
+
+// saveLanguages saves the edited language in place, or adds a new one, and
+// returns to management
+func (m *Model) saveLanguages() {
+	lang := models.Language{
+		Name:          strings.TrimSpace(m.fields[0].Value),
+		Level:         m.fields[1].Value,
+		Certification: strings.TrimSpace(m.fields[2].Value),
+	}
+
+	index := m.editingLanguage
+	if index >= 0 && index < len(m.resume.Languages) {
+		m.resume.Languages[index] = lang
+	} else {
+		m.resume.Languages = append(m.resume.Languages, lang)
+		index = len(m.resume.Languages) - 1
+	}
+
+	m.managingLanguages = true
+	m.editingLanguage = -1
+	m.selectedLanguage = index
+	m.fields = nil
+	m.currentField = 0
+	m.error = ""
+	m.editingList = false
+}
+
+// cancelLanguageEdit returns to language management when canceling edit
+func (m *Model) cancelLanguageEdit() {
+	m.managingLanguages = true
+	m.editingLanguage = -1
+	m.fields = nil
+	if m.selectedLanguage >= len(m.resume.Languages) || m.selectedLanguage < 0 {
+		m.selectedLanguage = 0
+	}
+	m.currentField = 0
+	m.error = ""
+	m.editingList = false
+}
+
+// deleteSelectedLanguage deletes the currently selected language
+func (m *Model) deleteSelectedLanguage() {
+	total := len(m.resume.Languages)
+	if !m.managingLanguages || total == 0 || m.selectedLanguage < 0 || m.selectedLanguage >= total {
+		return
+	}
+	idx := m.selectedLanguage
+	m.resume.Languages = append(m.resume.Languages[:idx], m.resume.Languages[idx+1:]...)
+	if m.selectedLanguage >= len(m.resume.Languages) {
+		m.selectedLanguage = max(len(m.resume.Languages)-1, 0)
+	}
+}
+
+// saveCustomSections saves the edited custom section in place, or adds a
+// new one, and returns to management
+func (m *Model) saveCustomSections() {
+	section := models.Section{
+		Title: strings.TrimSpace(m.fields[0].Value),
+		Items: m.fields[1].items(),
 	}
+
+	index := m.editingSection
+	if index >= 0 && index < len(m.resume.Additional) {
+		m.resume.Additional[index] = section
+	} else {
+		m.resume.Additional = append(m.resume.Additional, section)
+		index = len(m.resume.Additional) - 1
+	}
+
+	m.managingSections = true
+	m.editingSection = -1
+	m.selectedSection = index
+	m.fields = nil
+	m.currentField = 0
+	m.error = ""
+	m.editingList = false
+}
+
+// cancelSectionEdit returns to custom section management when canceling edit
+func (m *Model) cancelSectionEdit() {
+	m.managingSections = true
+	m.editingSection = -1
+	m.fields = nil
+	if m.selectedSection >= len(m.resume.Additional) || m.selectedSection < 0 {
+		m.selectedSection = 0
+	}
+	m.currentField = 0
+	m.error = ""
+	m.editingList = false
 }
 
-// deleteSelectedExperience deletes the currently selected experience in management mode
+// deleteSelectedSection deletes the currently selected custom section
+func (m *Model) deleteSelectedSection() {
+	total := len(m.resume.Additional)
+	if !m.managingSections || total == 0 || m.selectedSection < 0 || m.selectedSection >= total {
+		return
+	}
+	idx := m.selectedSection
+	m.resume.Additional = append(m.resume.Additional[:idx], m.resume.Additional[idx+1:]...)
+	if m.selectedSection >= len(m.resume.Additional) {
+		m.selectedSection = max(len(m.resume.Additional)-1, 0)
+	}
+}
+
+// moveSelectedSection moves the selected custom section up (-1) or down (1),
+// keeping it selected
+func (m *Model) moveSelectedSection(delta int) {
+	from, to := m.selectedSection, m.selectedSection+delta
+	if from < 0 || from >= len(m.resume.Additional) || to < 0 || to >= len(m.resume.Additional) {
+		return
+	}
+	sections := m.resume.Additional
+	sections[from], sections[to] = sections[to], sections[from]
+	m.selectedSection = to
+}
+
+// deleteSelectedExperience deletes the currently selected experience, or
+// the selected role of an experience with several, in management mode
 func (m *Model) deleteSelectedExperience() {
 	if !m.managingExperiences {
 		return
 	}
-	total := len(m.resume.Experience)
-	if total == 0 || m.selectedExperience < 0 || m.selectedExperience >= total {
+	rows := m.experienceRows()
+	if len(rows) == 0 || m.selectedExperience < 0 || m.selectedExperience >= len(rows) {
 		return
 	}
 
-	idx := m.selectedExperience
-	// Delete the selected item
-	m.resume.Experience = append(m.resume.Experience[:idx], m.resume.Experience[idx+1:]...)
+	row := rows[m.selectedExperience]
+	if row.role >= 0 {
+		exp := &m.resume.Experience[row.exp]
+		exp.Roles = append(exp.Roles[:row.role], exp.Roles[row.role+1:]...)
+		// A single remaining role becomes the experience's own position
+		if len(exp.Roles) == 1 {
+			role := exp.Roles[0]
+			exp.Position, exp.StartDate, exp.EndDate, exp.Current = role.Position, role.StartDate, role.EndDate, role.Current
+			exp.Responsibilities, exp.Achievements = role.Responsibilities, role.Achievements
+			exp.Roles = nil
+		}
+	} else {
+		// Delete the selected item
+		m.resume.Experience = append(m.resume.Experience[:row.exp], m.resume.Experience[row.exp+1:]...)
+	}
 
 	// Adjust selection
-	if len(m.resume.Experience) == 0 {
+	if total := len(m.experienceRows()); total == 0 {
 		m.selectedExperience = 0
-	} else if idx >= len(m.resume.Experience) {
-		m.selectedExperience = len(m.resume.Experience) - 1
-	} else {
-		m.selectedExperience = idx
+	} else if m.selectedExperience >= total {
+		m.selectedExperience = total - 1
 	}
 }
 
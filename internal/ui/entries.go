@@ -0,0 +1,243 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/validate"
+)
+
+// Certifications, awards, publications and volunteer roles are edited as
+// list items with their fields separated by "|". Fields the form does not
+// show, such as verification links, are kept from the entry with the same
+// name when saving. Skills use the same format for their optional level
+// and years.
+
+// entryFields splits a list item into n trimmed fields, padding missing ones
+func entryFields(item string, n int) []string {
+	fields := strings.SplitN(item, "|", n)
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	for len(fields) < n {
+		fields = append(fields, "")
+	}
+	return fields
+}
+
+// joinEntry joins fields with " | ", dropping empty trailing ones
+func joinEntry(fields ...string) string {
+	for len(fields) > 1 && fields[len(fields)-1] == "" {
+		fields = fields[:len(fields)-1]
+	}
+	return strings.Join(fields, " | ")
+}
+
+// mapItems formats each entry as a list item
+func mapItems[T any](entries []T, format func(T) string) []string {
+	items := make([]string, len(entries))
+	for i, entry := range entries {
+		items[i] = format(entry)
+	}
+	return items
+}
+
+// parseMonth parses an optional "2006-01" date
+func parseMonth(value, label string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if err := validate.Month(value); err != nil {
+		return time.Time{}, fmt.Errorf("%s格式错误: %s (%s)", label, value, ruleMessage(err))
+	}
+	t, _ := time.Parse("2006-01", value)
+	return t, nil
+}
+
+// formatMonth formats an optional date as "2006-01"
+func formatMonth(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01")
+}
+
+// formatCertification formats "名称 | 颁发机构 | 获得年月 | 证书编号 | 到期年月"
+func formatCertification(c models.Certification) string {
+	return joinEntry(c.Name, c.Issuer, formatMonth(c.Date), c.CredentialID, formatMonth(c.Expires))
+}
+
+func parseCertification(item string) (models.Certification, error) {
+	f := entryFields(item, 5)
+	if f[0] == "" {
+		return models.Certification{}, fmt.Errorf("请填写证书名称: %s", item)
+	}
+	date, err := parseMonth(f[2], "获得年月")
+	if err != nil {
+		return models.Certification{}, err
+	}
+	expires, err := parseMonth(f[4], "到期年月")
+	if err != nil {
+		return models.Certification{}, err
+	}
+	if !expires.IsZero() && expires.Before(date) {
+		return models.Certification{}, fmt.Errorf("到期年月早于获得年月: %s", item)
+	}
+	return models.Certification{Name: f[0], Issuer: f[1], Date: date, CredentialID: f[3], Expires: expires}, nil
+}
+
+// formatAward formats "名称 | 颁发机构 | 年月 | 说明"
+func formatAward(a models.Award) string {
+	return joinEntry(a.Title, a.Issuer, formatMonth(a.Date), a.Description)
+}
+
+func parseAward(item string) (models.Award, error) {
+	f := entryFields(item, 4)
+	if f[0] == "" {
+		return models.Award{}, fmt.Errorf("请填写奖项名称: %s", item)
+	}
+	date, err := parseMonth(f[2], "年月")
+	if err != nil {
+		return models.Award{}, err
+	}
+	return models.Award{Title: f[0], Issuer: f[1], Date: date, Description: f[3]}, nil
+}
+
+// formatPublication formats "标题 | 作者; 作者 | 发表于 | 年份 | DOI"
+func formatPublication(p models.Publication) string {
+	year := ""
+	if p.Year > 0 {
+		year = strconv.Itoa(p.Year)
+	}
+	return joinEntry(p.Title, strings.Join(p.Authors, "; "), p.Venue, year, p.DOI)
+}
+
+func parsePublication(item string) (models.Publication, error) {
+	f := entryFields(item, 5)
+	if f[0] == "" {
+		return models.Publication{}, fmt.Errorf("请填写论文标题: %s", item)
+	}
+	p := models.Publication{Title: f[0], Venue: f[2], DOI: f[4]}
+	for _, author := range strings.FieldsFunc(f[1], func(r rune) bool { return r == ';' || r == '；' || r == '、' }) {
+		if author = strings.TrimSpace(author); author != "" {
+			p.Authors = append(p.Authors, author)
+		}
+	}
+	if f[3] != "" {
+		if err := validate.Year(f[3]); err != nil {
+			return models.Publication{}, fmt.Errorf("年份格式错误: %s (%s)", f[3], ruleMessage(err))
+		}
+		p.Year, _ = strconv.Atoi(f[3])
+	}
+	return p, nil
+}
+
+// formatVolunteer formats "角色 | 组织 | 开始年月 | 结束年月"
+func formatVolunteer(v models.Volunteer) string {
+	end := formatMonth(v.EndDate)
+	if v.Current {
+		end = "current"
+	}
+	return joinEntry(v.Role, v.Organization, formatMonth(v.StartDate), end)
+}
+
+func parseVolunteer(item string) (models.Volunteer, error) {
+	f := entryFields(item, 4)
+	if f[0] == "" {
+		return models.Volunteer{}, fmt.Errorf("请填写角色: %s", item)
+	}
+	if f[1] == "" {
+		return models.Volunteer{}, fmt.Errorf("请填写组织名称: %s", item)
+	}
+	start, err := parseMonth(f[2], "开始年月")
+	if err != nil {
+		return models.Volunteer{}, err
+	}
+	v := models.Volunteer{Role: f[0], Organization: f[1], StartDate: start}
+	if f[3] == "current" {
+		v.Current = true
+	} else if v.EndDate, err = parseMonth(f[3], "结束年月"); err != nil {
+		return models.Volunteer{}, err
+	}
+	return v, nil
+}
+
+// skillLevelNames maps the Chinese level names offered in the form onto the
+// levels stored in the resume
+var skillLevelNames = map[string]string{
+	"入门": "beginner",
+	"了解": "elementary",
+	"熟悉": "intermediate",
+	"熟练": "advanced",
+	"精通": "expert",
+}
+
+// formatSkill formats "技能 | 水平 | 年限"
+func formatSkill(s models.Skill) string {
+	years := ""
+	if s.Years > 0 {
+		years = strconv.FormatFloat(s.Years, 'f', -1, 64)
+	}
+	return joinEntry(s.Name, s.Level, years)
+}
+
+func parseSkill(item string) (models.Skill, error) {
+	f := entryFields(item, 3)
+	if f[0] == "" {
+		return models.Skill{}, fmt.Errorf("技能名称不能为空")
+	}
+	s := models.Skill{Name: f[0], Level: f[1]}
+	if level, ok := skillLevelNames[s.Level]; ok {
+		s.Level = level
+	}
+	if !models.ValidSkillLevel(s.Level) {
+		return models.Skill{}, fmt.Errorf("水平格式错误: %s (请输入 1-5 或 入门/了解/熟悉/熟练/精通)", f[1])
+	}
+	if f[2] != "" {
+		years, err := strconv.ParseFloat(f[2], 64)
+		if err != nil || years < 0 {
+			return models.Skill{}, fmt.Errorf("年限格式错误: %s (请输入如: 3 或 1.5)", f[2])
+		}
+		s.Years = years
+	}
+	return s, nil
+}
+
+// formatSkills formats a skill list as the value of a list field
+func formatSkills(skills models.SkillList) string {
+	return strings.Join(mapItems(skills, formatSkill), ", ")
+}
+
+// parseSkills parses the items of a skill list field
+func parseSkills(items []string) (models.SkillList, error) {
+	var skills models.SkillList
+	for _, item := range items {
+		skill, err := parseSkill(item)
+		if err != nil {
+			return nil, err
+		}
+		skills = append(skills, skill)
+	}
+	return skills, nil
+}
+
+// formatSkillCategory formats a custom skill category as "分类名: 技能, 技能"
+func formatSkillCategory(c models.SkillCategory) string {
+	return c.Name + ": " + formatSkills(c.Items)
+}
+
+func parseSkillCategory(item string) (models.SkillCategory, error) {
+	name, skills, ok := strings.Cut(strings.Replace(item, "：", ":", 1), ":")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" {
+		return models.SkillCategory{}, fmt.Errorf("请按「分类名: 技能1, 技能2」填写: %s", item)
+	}
+	items, err := parseSkills(parseSkillList(skills))
+	if err != nil {
+		return models.SkillCategory{}, fmt.Errorf("%s: %w", name, err)
+	}
+	return models.SkillCategory{Name: name, Items: items}, nil
+}
@@ -48,8 +48,13 @@ func (m *Model) handleEnter() (tea.Model, tea.Cmd) {
 		// Check if we're in management mode
 		if m.managingExperiences {
 			// Enter edit mode for selected experience (or add new)
-			if len(m.resume.Experience) > 0 && m.selectedExperience < len(m.resume.Experience) {
-				m.enterExperienceEditMode(m.selectedExperience) // Edit selected
+			if rows := m.experienceRows(); m.selectedExperience < len(rows) {
+				row := rows[m.selectedExperience]
+				if row.role >= 0 {
+					m.enterRoleEditMode(row.exp, row.role) // Edit selected role
+				} else {
+					m.enterExperienceEditMode(row.exp) // Edit selected
+				}
 			} else {
 				m.enterExperienceEditMode(-1) // Add new if no experiences or invalid selection
 			}
@@ -66,10 +71,30 @@ func (m *Model) handleEnter() (tea.Model, tea.Cmd) {
 			return *m, nil
 		}
 
+		if m.managingLanguages {
+			// Enter edit mode for selected language (or add new)
+			if m.selectedLanguage < len(m.resume.Languages) {
+				m.enterLanguageEditMode(m.selectedLanguage)
+			} else {
+				m.enterLanguageEditMode(-1)
+			}
+			return *m, nil
+		}
+
+		if m.managingSections {
+			// Enter edit mode for selected section (or add new)
+			if m.selectedSection < len(m.resume.Additional) {
+				m.enterSectionEditMode(m.selectedSection)
+			} else {
+				m.enterSectionEditMode(-1)
+			}
+			return *m, nil
+		}
+
 		// Check if current field is a list field
-		// For Skills and CustomSections steps we keep Enter consistent with other steps (go next),
+		// On steps made of lists we keep Enter consistent with other steps (go next),
 		// so we do NOT auto-enter list editing here. List editing is opened via 'E'.
-		if m.currentStep != StepSkills && m.currentStep != StepCustomSections {
+		if !m.listsOpenWithE() {
 			if m.enterListEditingMode() {
 				return *m, nil
 			}
@@ -81,10 +106,11 @@ func (m *Model) handleEnter() (tea.Model, tea.Cmd) {
 		// Validate and save current step data
 		if m.validateCurrentStep() {
 			m.saveCurrentStep()
-			// When editing multi-item sections (Experience/Projects), return to management list
-			// immediately after saving instead of advancing to the next step, so the new/updated
-			// item is visible right away.
-			if m.currentStep == StepExperience || m.currentStep == StepProjects {
+			// When editing multi-item sections (Experience/Projects/Languages/Custom sections), return to
+			// management list immediately after saving instead of advancing to the next step, so the
+			// new/updated item is visible right away.
+			switch m.currentStep {
+			case StepExperience, StepProjects, StepLanguages, StepCustomSections:
 				return *m, nil
 			}
 			m.nextStep()
@@ -216,6 +242,16 @@ func (m *Model) handleListManagement(action string) {
 	}
 }
 
+// listsOpenWithE reports whether list fields on the current step open with
+// 'E', leaving Enter to advance to the next step
+func (m Model) listsOpenWithE() bool {
+	switch m.currentStep {
+	case StepSkills, StepCertifications, StepAwards, StepPublications, StepVolunteer, StepCustomSections:
+		return true
+	}
+	return false
+}
+
 // enterListEditingMode enters the list editing mode for a field
 func (m *Model) enterListEditingMode() bool {
 	if len(m.fields) == 0 || !m.fields[m.currentField].IsList {
@@ -223,7 +259,7 @@ func (m *Model) enterListEditingMode() bool {
 	}
 
 	m.editingList = true
-	m.listItems = parseSkillList(m.fields[m.currentField].Value)
+	m.listItems = m.fields[m.currentField].items()
 
 	// If no items exist, create a default empty item
 	if len(m.listItems) == 0 {
@@ -250,7 +286,7 @@ func (m *Model) exitListEditingMode() {
 				validItems = append(validItems, trimmed)
 			}
 		}
-		m.fields[m.currentField].Value = strings.Join(validItems, ", ")
+		m.fields[m.currentField].setItems(validItems)
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/timeline"
 )
 
 // listItem implements list.Item interface for the welcome list
@@ -39,17 +40,29 @@ type Model struct {
 	listItems   []string
 	listIndex   int
 
-	// Custom sections
-	customSections []CustomSection
-
 	// Experience/Project management
 	managingExperiences bool
 	managingProjects    bool
 	editingExperience   int // -1 for new, >= 0 for editing existing
 	editingProject      int // -1 for new, >= 0 for editing existing
-	selectedExperience  int // Currently selected experience in management list
+	selectedExperience  int // Currently selected row in the experience management list
+	editingRole         int // Role of editingExperience being edited, -1 for the experience itself
+	addingRole          bool
 	selectedProject     int // Currently selected project in management list
 
+	// Custom section management
+	managingSections bool
+	editingSection   int // -1 for new, >= 0 for editing existing
+	selectedSection  int // Currently selected section in management list
+
+	// Language management
+	managingLanguages bool
+	editingLanguage   int // -1 for new, >= 0 for editing existing
+	selectedLanguage  int // Currently selected language in management list
+
+	// Timeline checks shown on the confirmation screen
+	timelineOptions timeline.Options
+
 	// Bubbles components
 	welcomeList list.Model
 	textInputs  []textinput.Model
@@ -92,11 +105,10 @@ func NewModel() Model {
 			"查看示例",
 			"退出",
 		},
-		customSections: []CustomSection{},
-		welcomeList:    welcomeList,
-		textInputs:     []textinput.Model{},
-		textArea:       ta,
-		progressBar:    prog,
+		welcomeList: welcomeList,
+		textInputs:  []textinput.Model{},
+		textArea:    ta,
+		progressBar: prog,
 	}
 }
 
@@ -111,7 +123,7 @@ func (m *Model) createTextInputs() {
 		ti.CharLimit = 156
 		ti.Width = 50
 
-		if !field.Multiline && !field.IsList {
+		if !field.Multiline && !field.IsList && !field.isPicker() {
 			// Focus the first single-line input
 			if i == 0 {
 				ti.Focus()
@@ -159,7 +171,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if !m.editingList {
 			// Update all text inputs
 			for i := range m.textInputs {
-				if i < len(m.fields) && !m.fields[i].Multiline && !m.fields[i].IsList {
+				if i < len(m.fields) && !m.fields[i].Multiline && !m.fields[i].IsList && !m.fields[i].isPicker() {
 					m.textInputs[i], cmd = m.textInputs[i].Update(msg)
 					cmds = append(cmds, cmd)
 					// Sync textinput value back to field
@@ -204,6 +216,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			}
+			// The custom sections step always starts in management, so any
+			// form shown on it edits a section
+			if m.currentStep == StepCustomSections && !m.managingSections {
+				m.cancelSectionEdit()
+				return m, nil
+			}
+			if m.currentStep == StepLanguages && !m.managingLanguages {
+				m.cancelLanguageEdit()
+				return m, nil
+			}
 			if m.currentStep > StepWelcome {
 				m.currentStep--
 				m.setupStep()
@@ -216,8 +238,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "up":
 			// Handle navigation in management modes
 			if m.managingExperiences {
-				if len(m.resume.Experience) > 0 {
-					m.selectedExperience = (m.selectedExperience - 1 + len(m.resume.Experience)) % len(m.resume.Experience)
+				if rows := len(m.experienceRows()); rows > 0 {
+					m.selectedExperience = (m.selectedExperience - 1 + rows) % rows
 				}
 				return m, nil
 			}
@@ -227,6 +249,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.managingSections {
+				if len(m.resume.Additional) > 0 {
+					m.selectedSection = (m.selectedSection - 1 + len(m.resume.Additional)) % len(m.resume.Additional)
+				}
+				return m, nil
+			}
+			if m.managingLanguages {
+				if len(m.resume.Languages) > 0 {
+					m.selectedLanguage = (m.selectedLanguage - 1 + len(m.resume.Languages)) % len(m.resume.Languages)
+				}
+				return m, nil
+			}
 			// Arrow keys: when editing a list, move within list; otherwise move between fields
 			if m.currentStep != StepWelcome {
 				if m.editingList {
@@ -239,8 +273,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "down":
 			// Handle navigation in management modes
 			if m.managingExperiences {
-				if len(m.resume.Experience) > 0 {
-					m.selectedExperience = (m.selectedExperience + 1) % len(m.resume.Experience)
+				if rows := len(m.experienceRows()); rows > 0 {
+					m.selectedExperience = (m.selectedExperience + 1) % rows
 				}
 				return m, nil
 			}
@@ -250,6 +284,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.managingSections {
+				if len(m.resume.Additional) > 0 {
+					m.selectedSection = (m.selectedSection + 1) % len(m.resume.Additional)
+				}
+				return m, nil
+			}
+			if m.managingLanguages {
+				if len(m.resume.Languages) > 0 {
+					m.selectedLanguage = (m.selectedLanguage + 1) % len(m.resume.Languages)
+				}
+				return m, nil
+			}
 			// Arrow keys: when editing a list, move within list; otherwise move between fields
 			if m.currentStep != StepWelcome {
 				if m.editingList {
@@ -259,9 +305,31 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 
+		case "shift+up", "shift+down":
+			// Reorder custom sections
+			if m.managingSections {
+				if msg.String() == "shift+up" {
+					m.moveSelectedSection(-1)
+				} else {
+					m.moveSelectedSection(1)
+				}
+				return m, nil
+			}
+
+		case "left", "right":
+			// Cycle the options of a picker field
+			if !m.editingList && len(m.fields) > 0 && m.fields[m.currentField].isPicker() {
+				if msg.String() == "left" {
+					m.fields[m.currentField].cycle(-1)
+				} else {
+					m.fields[m.currentField].cycle(1)
+				}
+				return m, nil
+			}
+
 		case "tab":
 			// Handle tab in management modes
-			if m.managingExperiences || m.managingProjects {
+			if m.managingExperiences || m.managingProjects || m.managingSections || m.managingLanguages {
 				// Continue to next step from management
 				m.nextStep()
 				return m, nil
@@ -293,6 +361,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case "n", "N":
+			// Letters with a shortcut are plain text while editing a list item
+			if m.editingList {
+				m.handleTextInput(msg)
+				return m, nil
+			}
 			// Handle adding new experience/project in management mode
 			if m.managingExperiences {
 				m.enterExperienceEditMode(-1) // Add new experience
@@ -302,16 +375,45 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.enterProjectEditMode(-1) // Add new project
 				return m, nil
 			}
+			if m.managingSections {
+				m.enterSectionEditMode(-1) // Add new section
+				return m, nil
+			}
+			if m.managingLanguages {
+				m.enterLanguageEditMode(-1) // Add new language
+				return m, nil
+			}
 
 		case "e", "E":
-			// Explicitly enter list editing mode on Skills/CustomSections step
-			if m.currentStep == StepSkills || m.currentStep == StepCustomSections {
+			if m.editingList {
+				m.handleTextInput(msg)
+				return m, nil
+			}
+			// Explicitly enter list editing mode on steps made of lists
+			if m.listsOpenWithE() {
 				if m.enterListEditingMode() {
 					return m, nil
 				}
 			}
 
+		case "r", "R":
+			if m.editingList {
+				m.handleTextInput(msg)
+				return m, nil
+			}
+			// Add a role to the company of the selected experience
+			if m.managingExperiences {
+				if rows := m.experienceRows(); m.selectedExperience < len(rows) {
+					m.enterRoleEditMode(rows[m.selectedExperience].exp, -1)
+				}
+				return m, nil
+			}
+
 		case "d", "D":
+			if m.editingList {
+				m.handleTextInput(msg)
+				return m, nil
+			}
 			// Handle deleting selected experience/project in management mode
 			if m.managingExperiences {
 				m.deleteSelectedExperience()
@@ -321,6 +423,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.deleteSelectedProject()
 				return m, nil
 			}
+			if m.managingSections {
+				m.deleteSelectedSection()
+				return m, nil
+			}
+			if m.managingLanguages {
+				m.deleteSelectedLanguage()
+				return m, nil
+			}
 
 		default:
 			if m.editingList {
@@ -339,8 +449,8 @@ func (m Model) isCurrentFieldMultiline() bool {
 
 // calculateProgress returns the current progress percentage (0.0 to 1.0)
 func (m Model) calculateProgress() float64 {
-	// 总共有 9 个步骤 (Welcome=0, PersonalInfo=1, Summary=2, Education=3, Experience=4, Projects=5, Skills=6, CustomSections=7, Confirm=8, Finish=9)
-	totalSteps := float64(9)
+	// 从 Welcome=0 到 Finish 的所有步骤
+	totalSteps := float64(StepFinish)
 	currentStep := float64(m.currentStep)
 
 	// 限制在有效范围内
@@ -364,6 +474,11 @@ func (m Model) getStepName() string {
 		StepExperience:     "工作经验",
 		StepProjects:       "项目经验",
 		StepSkills:         "技能",
+		StepCertifications: "资格证书",
+		StepAwards:         "获奖经历",
+		StepPublications:   "论文发表",
+		StepVolunteer:      "志愿经历",
+		StepLanguages:      "语言能力",
 		StepCustomSections: "自定义章节",
 		StepConfirm:        "确认信息",
 		StepFinish:         "完成",
@@ -401,7 +516,7 @@ func (m *Model) focusCurrentField() {
 		m.textArea.SetValue(currentField.Value)
 		m.textArea.Placeholder = currentField.Placeholder
 		m.textArea.Focus()
-	} else if !currentField.IsList && m.currentField < len(m.textInputs) {
+	} else if !currentField.IsList && !currentField.isPicker() && m.currentField < len(m.textInputs) {
 		// Focus textinput for single-line fields
 		m.textInputs[m.currentField].SetValue(currentField.Value)
 		m.textInputs[m.currentField].Focus()
@@ -416,9 +531,11 @@ func (m *Model) syncInputsToFields() {
 
 	for i := range m.fields {
 		if m.fields[i].Multiline {
-			// Sync textarea value
-			m.fields[i].Value = m.textArea.Value()
-		} else if !m.fields[i].IsList && i < len(m.textInputs) {
+			// The textarea is shared, and only holds the focused field
+			if i == m.currentField {
+				m.fields[i].Value = m.textArea.Value()
+			}
+		} else if !m.fields[i].IsList && !m.fields[i].isPicker() && i < len(m.textInputs) {
 			// Sync textinput value
 			m.fields[i].Value = m.textInputs[i].Value()
 		}
@@ -2,6 +2,9 @@ package ui
 
 import (
 	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/validate"
 )
 
 // setupStep configures the form fields for the current step
@@ -15,8 +18,16 @@ func (m *Model) setupStep() {
 	m.managingProjects = false
 	m.editingExperience = -1
 	m.editingProject = -1
+	m.editingRole = -1
+	m.addingRole = false
 	m.selectedExperience = 0
 	m.selectedProject = 0
+	m.managingSections = false
+	m.editingSection = -1
+	m.selectedSection = 0
+	m.managingLanguages = false
+	m.editingLanguage = -1
+	m.selectedLanguage = 0
 
 	switch m.currentStep {
 	case StepPersonalInfo:
@@ -31,6 +42,20 @@ func (m *Model) setupStep() {
 		m.setupProjectsStep()
 	case StepSkills:
 		m.setupSkillsStep()
+	case StepCertifications:
+		m.setupEntriesStep("证书", "按E编辑列表，每项格式: 名称 | 颁发机构 | 获得年月 | 证书编号 | 到期年月",
+			mapItems(m.resume.Certifications, formatCertification))
+	case StepAwards:
+		m.setupEntriesStep("奖项", "按E编辑列表，每项格式: 名称 | 颁发机构 | 年月 | 说明",
+			mapItems(m.resume.Awards, formatAward))
+	case StepPublications:
+		m.setupEntriesStep("论文", "按E编辑列表，每项格式: 标题 | 作者1; 作者2 | 期刊/会议 | 年份 | DOI",
+			mapItems(m.resume.Publications, formatPublication))
+	case StepVolunteer:
+		m.setupEntriesStep("志愿经历", "按E编辑列表，每项格式: 角色 | 组织 | 开始年月 | 结束年月或current",
+			mapItems(m.resume.Volunteer, formatVolunteer))
+	case StepLanguages:
+		m.setupLanguagesStep()
 	case StepCustomSections:
 		m.setupCustomSectionsStep()
 	}
@@ -49,19 +74,34 @@ func (m *Model) setupStep() {
 
 // setupPersonalInfoStep sets up the personal information form fields
 func (m *Model) setupPersonalInfoStep() {
+	info := m.resume.PersonalInfo
 	m.fields = []FormField{
 		{Label: "姓名", Required: true, Placeholder: "如: 张三"},
-		{Label: "邮箱", Required: true, Placeholder: "如: zhangsan@example.com"},
-		{Label: "电话", Required: true, Placeholder: "如: 138-0013-8000"},
+		{Label: "职位头衔", Required: false, Placeholder: "如: 高级后端工程师 (可选)"},
+		{Label: "邮箱", Required: true, Placeholder: "如: zhangsan@example.com", Check: validate.Email},
+		{Label: "电话", Required: false, Placeholder: "如: 138-0013-8000 (可选)", Check: validate.Phone},
 		{Label: "地址", Required: true, Placeholder: "如: 北京市海淀区"},
-		{Label: "网站", Required: false, Placeholder: "如: www.github.com/username (可选)"},
+		{Label: "网站", Required: false, Placeholder: "如: zhangsan.dev (可选)", Check: validate.URL},
+		{Label: "GitHub", Required: false, Placeholder: "如: zhangsan 或 github.com/zhangsan (可选)", Check: validate.Profile},
+		{Label: "LinkedIn", Required: false, Placeholder: "如: zhangsan (可选)", Check: validate.Profile},
+		{Label: "其他链接", Required: false, Placeholder: "如: 博客 | blog.zhangsan.dev (可选)", IsList: true, Check: linkURL},
+		{Label: "照片", Required: false, Placeholder: "如: photo.jpg，相对简历文件路径，用于 HTML/PDF (可选)"},
 	}
 	// Load existing data
-	m.fields[0].Value = m.resume.PersonalInfo.Name
-	m.fields[1].Value = m.resume.PersonalInfo.Email
-	m.fields[2].Value = m.resume.PersonalInfo.Phone
-	m.fields[3].Value = m.resume.PersonalInfo.Location
-	m.fields[4].Value = m.resume.PersonalInfo.Website
+	m.fields[0].Value = info.Name
+	m.fields[1].Value = info.Title
+	m.fields[2].Value = info.Email
+	m.fields[3].Value = info.Phone
+	m.fields[4].Value = info.Location
+	m.fields[5].Value = info.Website
+	m.fields[6].Value = info.GitHub
+	m.fields[7].Value = info.LinkedIn
+	links := make([]string, len(info.Links))
+	for i, link := range info.Links {
+		links[i] = formatLink(link)
+	}
+	m.fields[8].Value = strings.Join(links, ", ")
+	m.fields[9].Value = info.Photo
 }
 
 // setupSummaryStep sets up the summary form fields
@@ -79,8 +119,8 @@ func (m *Model) setupEducationStep() {
 		{Label: "学位", Required: true, Placeholder: "如: 计算机科学学士、软件工程硕士"},
 		{Label: "专业", Required: false, Placeholder: "如: 计算机科学与技术 (可选)"},
 		{Label: "地点", Required: true, Placeholder: "如: 北京"},
-		{Label: "开始年份", Required: true, Placeholder: "如: 2020"},
-		{Label: "结束年份", Required: true, Placeholder: "如: 2024 或 current"},
+		{Label: "开始年份", Required: true, Placeholder: "如: 2020", Check: validate.Year},
+		{Label: "结束年份", Required: true, Placeholder: "如: 2024 或 current", Check: orCurrent(validate.Year)},
 	}
 
 	// Load existing education data if available
@@ -103,39 +143,40 @@ func (m *Model) setupEducationStep() {
 func (m *Model) setupExperienceStep() {
 	m.managingExperiences = true
 	m.editingExperience = -1
+	m.editingRole = -1
+	m.addingRole = false
 	m.selectedExperience = 0
 	m.fields = nil // Will be set when entering edit mode
 }
 
-// enterExperienceEditMode enters edit mode for a specific experience (index -1 for new)
-func (m *Model) enterExperienceEditMode(index int) {
-	m.managingExperiences = false
-	m.editingExperience = index
-
-	m.fields = []FormField{
+// experienceFields returns the form fields shared by experiences and roles
+func experienceFields() []FormField {
+	return []FormField{
 		{Label: "公司名称", Required: true, Placeholder: "如: 阿里巴巴集团"},
 		{Label: "职位", Required: true, Placeholder: "如: 高级软件工程师"},
 		{Label: "地点", Required: true, Placeholder: "如: 杭州"},
-		{Label: "开始年月", Required: true, Placeholder: "如: 2022-06"},
-		{Label: "结束年月", Required: true, Placeholder: "如: 2024-08 或 current"},
+		{Label: "开始年月", Required: true, Placeholder: "如: 2022-06", Check: validate.Month},
+		{Label: "结束年月", Required: true, Placeholder: "如: 2024-08 或 current", Check: orCurrent(validate.Month)},
 		{Label: "工作描述", Required: true, Placeholder: "如: 负责电商平台后端开发\n优化系统性能，提升30%处理速度\n参与微服务架构设计", Multiline: true},
+		{Label: "主要成就", Required: false, Placeholder: "如: 主导支付系统重构，故障率下降50%\n获得年度优秀员工 (可选)", Multiline: true},
 	}
+}
+
+// enterExperienceEditMode enters edit mode for a specific experience (index -1 for new)
+func (m *Model) enterExperienceEditMode(index int) {
+	m.managingExperiences = false
+	m.editingExperience = index
+	m.editingRole = -1
+	m.addingRole = false
+
+	m.fields = experienceFields()
 
 	// Load existing experience data if editing
 	if index >= 0 && index < len(m.resume.Experience) {
 		exp := m.resume.Experience[index]
 		m.fields[0].Value = exp.Company
-		m.fields[1].Value = exp.Position
 		m.fields[2].Value = exp.Location
-		m.fields[3].Value = exp.FormatStartDate()
-		if exp.Current {
-			m.fields[4].Value = "current"
-		} else {
-			m.fields[4].Value = exp.FormatEndDate()
-		}
-		if len(exp.Responsibilities) > 0 {
-			m.fields[5].Value = strings.Join(exp.Responsibilities, "\n")
-		}
+		m.loadRoleFields(exp.Positions()[0])
 	}
 
 	// Create input components and focus first field
@@ -144,6 +185,48 @@ func (m *Model) enterExperienceEditMode(index int) {
 	m.focusCurrentField()
 }
 
+// enterRoleEditMode enters edit mode for one role of an experience, or for a
+// new role at the same company when role is -1
+func (m *Model) enterRoleEditMode(index, role int) {
+	if index < 0 || index >= len(m.resume.Experience) {
+		return
+	}
+	exp := m.resume.Experience[index]
+	m.managingExperiences = false
+	m.editingExperience = index
+	m.editingRole = role
+	m.addingRole = role < 0
+
+	m.fields = experienceFields()
+	m.fields[0].Value = exp.Company
+	m.fields[2].Value = exp.Location
+	if role >= 0 && role < len(exp.Roles) {
+		m.loadRoleFields(exp.Roles[role])
+	}
+
+	// Start on the position, as the company is usually unchanged
+	m.createTextInputs()
+	m.currentField = 1
+	m.focusCurrentField()
+}
+
+// loadRoleFields fills the position, date, description and achievement fields
+func (m *Model) loadRoleFields(role models.Role) {
+	m.fields[1].Value = role.Position
+	m.fields[3].Value = formatMonth(role.StartDate)
+	if role.Current {
+		m.fields[4].Value = "current"
+	} else {
+		m.fields[4].Value = formatMonth(role.EndDate)
+	}
+	if len(role.Responsibilities) > 0 {
+		m.fields[5].Value = strings.Join(role.Responsibilities, "\n")
+	}
+	if len(role.Achievements) > 0 {
+		m.fields[6].Value = strings.Join(role.Achievements, "\n")
+	}
+}
+
 // setupProjectsStep sets up the projects management or form fields
 func (m *Model) setupProjectsStep() {
 	m.managingProjects = true
@@ -161,9 +244,12 @@ func (m *Model) enterProjectEditMode(index int) {
 		{Label: "项目名称", Required: true, Placeholder: "如: 在线教育平台"},
 		{Label: "项目描述", Required: true, Placeholder: "如: 基于React和Node.js的在线学习系统"},
 		{Label: "地点", Required: false, Placeholder: "如: 北京 (可选)"},
-		{Label: "开始年月", Required: true, Placeholder: "如: 2023-01"},
-		{Label: "结束年月", Required: true, Placeholder: "如: 2023-06 或 current"},
+		{Label: "开始年月", Required: true, Placeholder: "如: 2023-01", Check: validate.Month},
+		{Label: "结束年月", Required: true, Placeholder: "如: 2023-06 或 current", Check: orCurrent(validate.Month)},
 		{Label: "项目详情", Required: true, Placeholder: "如: 负责前端页面开发和API设计\n实现用户认证和课程管理功能\n使用Redis缓存提升系统性能", Multiline: true},
+		{Label: "技术栈", Required: false, Placeholder: "如: React, Node.js, Redis (可选)", IsList: true},
+		{Label: "项目链接", Required: false, Placeholder: "如: https://example.com (可选)", Check: validate.URL},
+		{Label: "代码仓库", Required: false, Placeholder: "如: https://github.com/user/repo (可选)", Check: validate.URL},
 	}
 
 	// Load existing project data if editing
@@ -172,15 +258,18 @@ func (m *Model) enterProjectEditMode(index int) {
 		m.fields[0].Value = proj.Name
 		m.fields[1].Value = proj.Description
 		m.fields[2].Value = proj.Location
-		m.fields[3].Value = proj.FormatStartDate()
+		m.fields[3].Value = formatMonth(proj.StartDate)
 		if proj.Current {
 			m.fields[4].Value = "current"
 		} else {
-			m.fields[4].Value = proj.FormatEndDate()
+			m.fields[4].Value = formatMonth(proj.EndDate)
 		}
 		if len(proj.Details) > 0 {
 			m.fields[5].Value = strings.Join(proj.Details, "\n")
 		}
+		m.fields[6].setItems(proj.Technologies)
+		m.fields[7].Value = proj.URL
+		m.fields[8].Value = proj.Repository
 	}
 
 	// Create input components and focus first field
@@ -189,49 +278,104 @@ func (m *Model) enterProjectEditMode(index int) {
 	m.focusCurrentField()
 }
 
-// setupSkillsStep sets up the skills form fields with default values
+// setupSkillsStep sets up the skills form fields, with default values when
+// no skills have been entered yet
 func (m *Model) setupSkillsStep() {
-	// Default skill categories if none exist
-	defaultLanguages := []string{"JavaScript", "Python", "Go", "Java", "TypeScript"}
-	defaultFrameworks := []string{"React", "Node.js", "Express", "Vue.js", "Django"}
-	defaultDatabases := []string{"PostgreSQL", "MongoDB", "Redis", "MySQL"}
-	defaultOther := []string{"Git", "Docker", "Linux", "AWS", "Jenkins"}
-
-	// Use existing skills or defaults
-	languages := m.resume.Skills.Languages
-	if len(languages) == 0 {
-		languages = defaultLanguages
+	skills := m.resume.Skills
+	if skills.IsEmpty() {
+		skills = models.Skills{
+			Languages:  models.SkillNames("JavaScript", "Python", "Go", "Java", "TypeScript"),
+			Frameworks: models.SkillNames("React", "Node.js", "Express", "Vue.js", "Django"),
+			Databases:  models.SkillNames("PostgreSQL", "MongoDB", "Redis", "MySQL"),
+			Tools:      models.SkillNames("Git", "Docker", "Linux", "AWS", "Jenkins"),
+		}
 	}
 
-	frameworks := m.resume.Skills.Frameworks
-	if len(frameworks) == 0 {
-		frameworks = defaultFrameworks
+	// Each item is "技能 | 水平 | 年限"; level and years are optional. Custom
+	// categories are one item each, added, renamed or deleted in the list editor.
+	custom := FormField{Label: "自定义分类", Required: false, Placeholder: "按E编辑列表，每项如: 云服务: AWS | 4, GCP", IsList: true, Lines: true}
+	custom.setItems(mapItems(skills.Custom, formatSkillCategory))
+	m.fields = []FormField{
+		{Label: "编程语言", Required: false, Placeholder: "按E编辑列表，如: Go | 精通 | 5", IsList: true, Value: formatSkills(skills.Languages)},
+		{Label: "框架/库", Required: false, Placeholder: "按E编辑列表，如: React | 4 | 3", IsList: true, Value: formatSkills(skills.Frameworks)},
+		{Label: "数据库", Required: false, Placeholder: "按E编辑列表", IsList: true, Value: formatSkills(skills.Databases)},
+		{Label: "工具", Required: false, Placeholder: "按E编辑列表", IsList: true, Value: formatSkills(skills.Tools)},
+		{Label: "其他", Required: false, Placeholder: "按E编辑列表", IsList: true, Value: formatSkills(skills.Other)},
+		custom,
 	}
+}
 
-	databases := m.resume.Skills.Databases
-	if len(databases) == 0 {
-		databases = defaultDatabases
+// setupEntriesStep sets up a step edited as a single list of "|"-separated
+// entries, such as certifications
+func (m *Model) setupEntriesStep(label, placeholder string, items []string) {
+	m.fields = []FormField{
+		{Label: label, Required: false, Placeholder: placeholder, IsList: true, Lines: true},
 	}
+	m.fields[0].setItems(items)
+}
 
-	other := m.resume.Skills.Other
-	if len(other) == 0 {
-		other = defaultOther
-	}
+// setupLanguagesStep sets up the language management
+func (m *Model) setupLanguagesStep() {
+	m.managingLanguages = true
+	m.editingLanguage = -1
+	m.selectedLanguage = 0
+	m.fields = nil // Will be set when entering edit mode
+}
+
+// enterLanguageEditMode enters edit mode for a specific language (index -1 for new)
+func (m *Model) enterLanguageEditMode(index int) {
+	m.managingLanguages = false
+	m.editingLanguage = index
 
 	m.fields = []FormField{
-		{Label: "编程语言", Required: false, Placeholder: "按E编辑列表", IsList: true, Value: strings.Join(languages, ", ")},
-		{Label: "框架/库", Required: false, Placeholder: "按E编辑列表", IsList: true, Value: strings.Join(frameworks, ", ")},
-		{Label: "数据库", Required: false, Placeholder: "按E编辑列表", IsList: true, Value: strings.Join(databases, ", ")},
-		{Label: "其他工具", Required: false, Placeholder: "按E编辑列表", IsList: true, Value: strings.Join(other, ", ")},
+		{Label: "语言", Required: true, Placeholder: "如: 英语、日语"},
+		{Label: "水平", Required: true, Value: "fluent", Options: models.LanguageLevels},
+		{Label: "证书/成绩", Required: false, Placeholder: "如: CEFR C1、IELTS 7.5、HSK 5 (可选)"},
+	}
+
+	// Load existing language data if editing
+	if index >= 0 && index < len(m.resume.Languages) {
+		lang := m.resume.Languages[index]
+		m.fields[0].Value = lang.Name
+		m.fields[1].Value = strings.ToLower(strings.TrimSpace(lang.Level))
+		m.fields[2].Value = lang.Certification
 	}
+
+	// Create input components and focus first field
+	m.createTextInputs()
+	m.currentField = 0
+	m.focusCurrentField()
 }
 
-// setupCustomSectionsStep sets up the custom sections form fields
+// setupCustomSectionsStep sets up the custom sections management
 func (m *Model) setupCustomSectionsStep() {
+	m.managingSections = true
+	m.editingSection = -1
+	m.selectedSection = 0
+	m.fields = nil // Will be set when entering edit mode
+}
+
+// enterSectionEditMode enters edit mode for a specific custom section (index -1 for new)
+func (m *Model) enterSectionEditMode(index int) {
+	m.managingSections = false
+	m.editingSection = index
+
 	m.fields = []FormField{
-		{Label: "自定义章节标题", Required: false, Placeholder: "如: 获得证书、获奖经历、志愿活动 (可留空跳过)"},
-		{Label: "章节内容", Required: false, Placeholder: "按E编辑列表", IsList: true},
+		{Label: "章节标题", Required: true, Placeholder: "如: 开源贡献、兴趣爱好"},
+		{Label: "章节内容", Required: true, Placeholder: "按E编辑列表，每项一条", IsList: true, Lines: true},
 	}
+
+	// Load existing section data if editing
+	if index >= 0 && index < len(m.resume.Additional) {
+		section := m.resume.Additional[index]
+		m.fields[0].Value = section.Title
+		m.fields[1].setItems(section.Items)
+	}
+
+	// Create input components and focus first field
+	m.createTextInputs()
+	m.currentField = 0
+	m.focusCurrentField()
 }
 
 // nextStep advances to the next step in the creation flow
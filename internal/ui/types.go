@@ -1,5 +1,13 @@
 package ui
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/validate"
+)
+
 // Step constants define the steps in the resume creation flow
 const (
 	StepWelcome = iota
@@ -9,6 +17,11 @@ const (
 	StepExperience
 	StepProjects
 	StepSkills
+	StepCertifications
+	StepAwards
+	StepPublications
+	StepVolunteer
+	StepLanguages
 	StepCustomSections
 	StepConfirm
 	StepFinish
@@ -21,11 +34,91 @@ type FormField struct {
 	Required    bool
 	Placeholder string
 	Multiline   bool
-	IsList      bool // For comma-separated lists
+	IsList      bool          // For comma-separated lists
+	Lines       bool          // List items are kept one per line, for items that may contain commas
+	Options     []string      // Values a picker field cycles through with ←/→
+	Check       validate.Rule // Checks the value, or each item of a list, as it is typed
+}
+
+// optionLabels are the labels shown for picker values
+var optionLabels = map[string]string{
+	"native":         "母语",
+	"fluent":         "流利",
+	"conversational": "日常交流",
+	"basic":          "基础",
+}
+
+// problem returns why the field value is invalid, or "" when it is valid or
+// has no check
+func (f FormField) problem() string {
+	if f.Check == nil {
+		return ""
+	}
+	if f.IsList {
+		for i, item := range f.items() {
+			if err := f.Check(item); err != nil {
+				return fmt.Sprintf("第 %d 项: %s", i+1, ruleMessage(err))
+			}
+		}
+		return ""
+	}
+	if err := f.Check(strings.TrimSpace(f.Value)); err != nil {
+		return ruleMessage(err)
+	}
+	return ""
+}
+
+// isPicker reports whether the field is chosen from Options instead of typed
+func (f FormField) isPicker() bool {
+	return len(f.Options) > 0
+}
+
+// cycle selects the next (delta 1) or previous (delta -1) option
+func (f *FormField) cycle(delta int) {
+	current := slices.Index(f.Options, f.Value)
+	if current < 0 {
+		current = 0
+		if delta < 0 {
+			current = 1
+		}
+	}
+	n := len(f.Options)
+	f.Value = f.Options[((current+delta)%n+n)%n]
+}
+
+// items returns the items of a list field
+func (f FormField) items() []string {
+	if !f.Lines {
+		return parseSkillList(f.Value)
+	}
+	var items []string
+	for _, line := range strings.Split(f.Value, "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+	return items
+}
+
+// setItems stores the items of a list field
+func (f *FormField) setItems(items []string) {
+	separator := ", "
+	if f.Lines {
+		separator = "\n"
+	}
+	f.Value = strings.Join(items, separator)
 }
 
-// CustomSection represents a user-defined section
-type CustomSection struct {
-	Title string
-	Items []string
+// displayValue returns the value as shown on one line of the form
+func (f FormField) displayValue() string {
+	if f.isPicker() {
+		if label, ok := optionLabels[f.Value]; ok {
+			return fmt.Sprintf("%s (%s)", label, f.Value)
+		}
+		return f.Value
+	}
+	if f.Lines {
+		return strings.Join(f.items(), "; ")
+	}
+	return f.Value
 }
@@ -1,9 +1,12 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
 	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/validate"
 )
 
 // validateCurrentStep validates the current step's form data
@@ -18,26 +21,52 @@ func (m *Model) validateCurrentStep() bool {
 		}
 	}
 
-	// Special validation for date fields
-	if m.currentStep == StepEducation || m.currentStep == StepExperience || m.currentStep == StepProjects {
-		// Validate year formats
-		for i, field := range m.fields {
-			if strings.Contains(field.Label, "年份") || strings.Contains(field.Label, "年月") {
-				if field.Value != "current" && field.Value != "" {
-					if m.currentStep == StepEducation {
-						if _, err := strconv.Atoi(field.Value); err != nil {
-							m.error = fmt.Sprintf("年份格式错误: %s (请输入如: 2020)", field.Label)
-							m.currentField = i
-							return false
-						}
-					} else {
-						// For experience and projects, expect YYYY-MM format
-						if field.Value != "current" && !strings.Contains(field.Value, "-") {
-							m.error = fmt.Sprintf("日期格式错误: %s (请输入如: 2022-06)", field.Label)
-							m.currentField = i
-							return false
-						}
+	// Fields with a check (dates, email, phone, links) are already marked
+	// while typing; stop on the first invalid one
+	for i, field := range m.fields {
+		if problem := field.problem(); problem != "" {
+			m.error = fmt.Sprintf("%s: %s", field.Label, problem)
+			m.currentField = i
+			return false
+		}
+	}
+
+	if m.currentStep == StepLanguages && !models.ValidLanguageLevel(m.fields[1].Value) {
+		m.error = fmt.Sprintf("语言水平无效: %s (可选: %s)", m.fields[1].Value, strings.Join(models.LanguageLevels, ", "))
+		m.currentField = 1
+		return false
+	}
+
+	// Certifications, awards, publications and volunteer roles are parsed
+	// from their list items, skills from the items of every category
+	var parse func(string) error
+	lists := 1
+	switch m.currentStep {
+	case StepSkills:
+		parse = func(item string) error { _, err := parseSkill(item); return err }
+		lists = len(m.fields) - 1 // The last field holds custom categories
+		if !m.validateSkillCategories() {
+			return false
+		}
+	case StepCertifications:
+		parse = func(item string) error { _, err := parseCertification(item); return err }
+	case StepAwards:
+		parse = func(item string) error { _, err := parseAward(item); return err }
+	case StepPublications:
+		parse = func(item string) error { _, err := parsePublication(item); return err }
+	case StepVolunteer:
+		parse = func(item string) error { _, err := parseVolunteer(item); return err }
+	}
+	if parse != nil {
+		for f := 0; f < lists; f++ {
+			for i, item := range m.fields[f].items() {
+				if err := parse(item); err != nil {
+					m.error = fmt.Sprintf("第 %d 项: %s", i+1, err)
+					if lists > 1 {
+						m.error = m.fields[f].Label + " " + m.error
 					}
+					m.currentField = f
+					return false
 				}
 			}
 		}
@@ -45,3 +74,59 @@ func (m *Model) validateCurrentStep() bool {
 
 	return true
 }
+
+// validateSkillCategories checks the custom skill categories field, each
+// item of which is a category with a unique name
+func (m *Model) validateSkillCategories() bool {
+	field := len(m.fields) - 1
+	seen := make(map[string]bool)
+	for i, item := range m.fields[field].items() {
+		category, err := parseSkillCategory(item)
+		if err == nil && seen[strings.ToLower(category.Name)] {
+			err = fmt.Errorf("分类名称重复: %s", category.Name)
+		}
+		if err != nil {
+			m.error = fmt.Sprintf("%s 第 %d 项: %s", m.fields[field].Label, i+1, err)
+			m.currentField = field
+			return false
+		}
+		seen[strings.ToLower(category.Name)] = true
+	}
+	return true
+}
+
+// ruleMessage describes a failed field rule for the form
+func ruleMessage(err error) string {
+	switch {
+	case errors.Is(err, validate.ErrMonth):
+		return "请输入年月，如: 2022-06"
+	case errors.Is(err, validate.ErrYear):
+		return "请输入四位年份，如: 2020"
+	case errors.Is(err, validate.ErrYearRange):
+		return fmt.Sprintf("年份应在 %d 到 %d 之间", validate.MinYear, validate.MaxYear())
+	case errors.Is(err, validate.ErrEmail):
+		return "邮箱格式错误，如: zhangsan@example.com"
+	case errors.Is(err, validate.ErrPhone):
+		return "电话格式错误，应为 7-15 位数字，可含 +、空格、-、括号"
+	case errors.Is(err, validate.ErrURL):
+		return "链接格式错误，如: example.com 或 https://example.com"
+	case errors.Is(err, validate.ErrProfile):
+		return "请输入用户名或链接，如: zhangsan 或 github.com/zhangsan"
+	}
+	return err.Error()
+}
+
+// orCurrent extends a date rule to accept "current" for an ongoing entry
+func orCurrent(rule validate.Rule) validate.Rule {
+	return func(value string) error {
+		if value == "current" {
+			return nil
+		}
+		return rule(value)
+	}
+}
+
+// linkURL checks the URL of a "label | url" link item
+func linkURL(item string) error {
+	return validate.URL(parseLink(item).URL)
+}
@@ -3,6 +3,9 @@ package ui
 import (
 	"fmt"
 	"strings"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/timeline"
 )
 
 // View renders the current view based on the model state
@@ -55,13 +58,32 @@ func (m Model) renderConfirmView() string {
 
 	// Personal Information
 	s.WriteString("👤 个人信息:\n")
-	s.WriteString(fmt.Sprintf("  姓名: %s\n", m.resume.PersonalInfo.Name))
-	s.WriteString(fmt.Sprintf("  邮箱: %s\n", m.resume.PersonalInfo.Email))
-	if m.resume.PersonalInfo.Phone != "" {
-		s.WriteString(fmt.Sprintf("  电话: %s\n", m.resume.PersonalInfo.Phone))
+	info := m.resume.PersonalInfo
+	s.WriteString(fmt.Sprintf("  姓名: %s\n", info.Name))
+	if info.Title != "" {
+		s.WriteString(fmt.Sprintf("  职位头衔: %s\n", info.Title))
+	}
+	s.WriteString(fmt.Sprintf("  邮箱: %s\n", info.Email))
+	if info.Phone != "" {
+		s.WriteString(fmt.Sprintf("  电话: %s\n", info.Phone))
+	}
+	if info.Location != "" {
+		s.WriteString(fmt.Sprintf("  地址: %s\n", info.Location))
+	}
+	if info.Website != "" {
+		s.WriteString(fmt.Sprintf("  网站: %s\n", info.Website))
+	}
+	if info.GitHub != "" {
+		s.WriteString(fmt.Sprintf("  GitHub: %s\n", info.GitHub))
+	}
+	if info.LinkedIn != "" {
+		s.WriteString(fmt.Sprintf("  LinkedIn: %s\n", info.LinkedIn))
 	}
-	if m.resume.PersonalInfo.Location != "" {
-		s.WriteString(fmt.Sprintf("  地址: %s\n", m.resume.PersonalInfo.Location))
+	for _, link := range info.Links {
+		s.WriteString(fmt.Sprintf("  链接: %s\n", formatLink(link)))
+	}
+	if info.Photo != "" {
+		s.WriteString(fmt.Sprintf("  照片: %s\n", info.Photo))
 	}
 	s.WriteString("\n")
 
@@ -102,6 +124,16 @@ func (m Model) renderConfirmView() string {
 			// 公司名称
 			s.WriteString(fmt.Sprintf("  %s\n", exp.Company))
 
+			if exp.HasRoles() {
+				// 总任职时间和各职位
+				timeStr := fmt.Sprintf("%s-%s", exp.FormatStartDate(), exp.FormatEndDate())
+				s.WriteString(fmt.Sprintf("    %s | %s\n", exp.Location, timeStr))
+				for _, role := range exp.Roles {
+					s.WriteString(fmt.Sprintf("    · %s (%s-%s)\n", role.Position, role.FormatStartDate(), role.FormatEndDate()))
+				}
+				continue
+			}
+
 			// 职位
 			s.WriteString(fmt.Sprintf("    %s\n", exp.Position))
 
@@ -129,18 +161,51 @@ func (m Model) renderConfirmView() string {
 			} else {
 				s.WriteString(fmt.Sprintf("    %s\n", timeStr))
 			}
+
+			// 技术栈
+			if len(proj.Technologies) > 0 {
+				s.WriteString(fmt.Sprintf("    技术栈: %s\n", strings.Join(proj.Technologies, ", ")))
+			}
 		}
 		s.WriteString("\n")
 	}
 
 	// Skills
-	if len(m.resume.Skills.Languages) > 0 || len(m.resume.Skills.Frameworks) > 0 {
+	if skills := m.resume.Skills; !skills.IsEmpty() {
 		s.WriteString("🛠️ 技能:\n")
-		if len(m.resume.Skills.Languages) > 0 {
-			s.WriteString(fmt.Sprintf("  编程语言: %s\n", strings.Join(m.resume.Skills.Languages, ", ")))
+		categories := []models.SkillCategory{
+			{Name: "编程语言", Items: skills.Languages},
+			{Name: "框架/库", Items: skills.Frameworks},
+			{Name: "数据库", Items: skills.Databases},
+			{Name: "工具", Items: skills.Tools},
+			{Name: "其他", Items: skills.Other},
+		}
+		for _, category := range append(categories, skills.Custom...) {
+			if len(category.Items) > 0 {
+				s.WriteString(fmt.Sprintf("  %s\n", formatSkillCategory(category)))
+			}
+		}
+		s.WriteString("\n")
+	}
+
+	// Certifications, awards, publications, volunteer roles and languages
+	typed := []struct {
+		title string
+		items []string
+	}{
+		{"📜 资格证书", mapItems(m.resume.Certifications, formatCertification)},
+		{"🏆 获奖经历", mapItems(m.resume.Awards, formatAward)},
+		{"📚 论文发表", mapItems(m.resume.Publications, formatPublication)},
+		{"🤝 志愿经历", mapItems(m.resume.Volunteer, formatVolunteer)},
+		{"🌐 语言能力", mapItems(m.resume.Languages, languageSummary)},
+	}
+	for _, section := range typed {
+		if len(section.items) == 0 {
+			continue
 		}
-		if len(m.resume.Skills.Frameworks) > 0 {
-			s.WriteString(fmt.Sprintf("  框架/库: %s\n", strings.Join(m.resume.Skills.Frameworks, ", ")))
+		s.WriteString(section.title + ":\n")
+		for _, item := range section.items {
+			s.WriteString(fmt.Sprintf("  • %s\n", item))
 		}
 		s.WriteString("\n")
 	}
@@ -157,6 +222,15 @@ func (m Model) renderConfirmView() string {
 		s.WriteString("\n")
 	}
 
+	// Timeline warnings do not block saving, but are worth a second look
+	if warnings := timeline.Check(&m.resume, m.timelineOptions); len(warnings) > 0 {
+		s.WriteString("⚠️ 时间线提醒:\n")
+		for _, w := range warnings {
+			s.WriteString(fmt.Sprintf("  • %s\n", w.MessageZH()))
+		}
+		s.WriteString("\n")
+	}
+
 	s.WriteString("Enter 保存简历，Esc 返回修改\n")
 
 	return s.String()
@@ -183,6 +257,12 @@ func (m Model) renderFormView() string {
 	if m.managingProjects {
 		return m.renderProjectManagement()
 	}
+	if m.managingLanguages {
+		return m.renderLanguageManagement()
+	}
+	if m.managingSections {
+		return m.renderSectionManagement()
+	}
 
 	stepNames := map[int]string{
 		StepPersonalInfo:   "📝 个人信息",
@@ -191,6 +271,11 @@ func (m Model) renderFormView() string {
 		StepExperience:     "💼 工作经验",
 		StepProjects:       "🚀 项目经验",
 		StepSkills:         "🛠️ 技能",
+		StepCertifications: "📜 资格证书",
+		StepAwards:         "🏆 获奖经历",
+		StepPublications:   "📚 论文发表",
+		StepVolunteer:      "🤝 志愿经历",
+		StepLanguages:      "🌐 语言能力",
 		StepCustomSections: "✨ 自定义章节",
 	}
 
@@ -234,11 +319,13 @@ func (m Model) renderFormView() string {
 			if i == m.currentField {
 				if field.IsList {
 					// Skills/CustomSections use 'E' to enter list editing to keep Enter as next-step
-					if m.currentStep == StepSkills || m.currentStep == StepCustomSections {
-						s.WriteString(fmt.Sprintf("  [%s] (按E编辑)\n", field.Value))
+					if m.listsOpenWithE() {
+						s.WriteString(fmt.Sprintf("  [%s] (按E编辑)\n", field.displayValue()))
 					} else {
-						s.WriteString(fmt.Sprintf("  [%s] (按Enter编辑)\n", field.Value))
+						s.WriteString(fmt.Sprintf("  [%s] (按Enter编辑)\n", field.displayValue()))
 					}
+				} else if field.isPicker() {
+					s.WriteString(fmt.Sprintf("  ◀ %s ▶ (←/→ 选择)\n", field.displayValue()))
 				} else if field.Multiline {
 					// Render textarea for multiline fields
 					s.WriteString(fmt.Sprintf("  %s\n", m.textArea.View()))
@@ -247,12 +334,15 @@ func (m Model) renderFormView() string {
 					s.WriteString(fmt.Sprintf("  %s\n", m.textInputs[i].View()))
 				}
 			} else {
-				value := field.Value
+				value := field.displayValue()
 				if value == "" {
 					value = fmt.Sprintf("(%s)", field.Placeholder)
 				}
 				s.WriteString(fmt.Sprintf("  %s\n", value))
 			}
+			if problem := field.problem(); problem != "" {
+				s.WriteString(fmt.Sprintf("  ✗ %s\n", problem))
+			}
 			s.WriteString("\n")
 		}
 
@@ -260,7 +350,7 @@ func (m Model) renderFormView() string {
 			s.WriteString(fmt.Sprintf("❌ %s\n\n", m.error))
 		}
 
-		if m.currentStep == StepSkills || m.currentStep == StepCustomSections {
+		if m.listsOpenWithE() {
 			s.WriteString("Enter 下一步，E 编辑当前列表，↑/↓ 或 Tab/Shift+Tab 切换字段，Del 删除项（在列表编辑模式），Esc 返回上一步\n")
 		} else {
 			s.WriteString("Enter 下一步，↑/↓ 或 Tab(向下)/Shift+Tab(向上) 切换字段，j/k 仅用于输入，Esc 返回上一步\n")
@@ -288,12 +378,22 @@ func (m Model) renderExperienceManagement() string {
 		s.WriteString("暂无工作经验\n\n")
 	} else {
 		s.WriteString("已有工作经验:\n")
-		for i, exp := range m.resume.Experience {
+		for i, row := range m.experienceRows() {
 			cursor := "  "
 			if i == m.selectedExperience {
 				cursor = "▶ "
 			}
-			s.WriteString(fmt.Sprintf("%s%d. %s - %s (%s)\n", cursor, i+1, exp.Company, exp.Position, exp.FormatStartDate()))
+			exp := m.resume.Experience[row.exp]
+			if row.role < 0 {
+				s.WriteString(fmt.Sprintf("%s%d. %s - %s (%s)\n", cursor, row.exp+1, exp.Company, exp.Position, exp.FormatStartDate()))
+				continue
+			}
+			// Experiences with several roles show the company once, then each role
+			if row.role == 0 {
+				s.WriteString(fmt.Sprintf("  %d. %s (%s)\n", row.exp+1, exp.Company, exp.DateRange()))
+			}
+			role := exp.Roles[row.role]
+			s.WriteString(fmt.Sprintf("%s   · %s (%s)\n", cursor, role.Position, role.FormatStartDate()))
 		}
 		s.WriteString("\n")
 	}
@@ -302,7 +402,8 @@ func (m Model) renderExperienceManagement() string {
 	s.WriteString("  ↑/↓ 浏览经历列表\n")
 	s.WriteString("  Enter 编辑选中的经历\n")
 	s.WriteString("  N 添加新的工作经历\n")
-	s.WriteString("  D 删除选中的经历\n")
+	s.WriteString("  R 在选中经历的公司添加新职位（晋升/转岗）\n")
+	s.WriteString("  D 删除选中的经历或职位\n")
 	s.WriteString("  Tab 继续下一步\n")
 	s.WriteString("  Esc 返回上一步\n")
 
@@ -347,3 +448,95 @@ func (m Model) renderProjectManagement() string {
 
 	return s.String()
 }
+
+// renderLanguageManagement renders the language management interface
+func (m Model) renderLanguageManagement() string {
+	var s strings.Builder
+
+	// Show progress bar
+	progress := m.calculateProgress()
+	stepName := m.getStepName()
+
+	s.WriteString(fmt.Sprintf("📋 简历创建进度 - %s (%.0f%%)\n", stepName, progress*100))
+	s.WriteString(m.progressBar.ViewAs(progress))
+	s.WriteString("\n\n")
+
+	s.WriteString("🌐 语言能力管理\n\n")
+
+	if len(m.resume.Languages) == 0 {
+		s.WriteString("暂无语言能力 (可直接 Tab 跳过)\n\n")
+	} else {
+		s.WriteString("已有语言能力:\n")
+		for i, lang := range m.resume.Languages {
+			cursor := "  "
+			if i == m.selectedLanguage {
+				cursor = "▶ "
+			}
+			s.WriteString(fmt.Sprintf("%s%d. %s\n", cursor, i+1, languageSummary(lang)))
+		}
+		s.WriteString("\n")
+	}
+
+	s.WriteString("选择操作:\n")
+	s.WriteString("  ↑/↓ 浏览语言列表\n")
+	s.WriteString("  Enter 编辑选中的语言\n")
+	s.WriteString("  N 添加新的语言\n")
+	s.WriteString("  D 删除选中的语言\n")
+	s.WriteString("  Tab 继续下一步\n")
+	s.WriteString("  Esc 返回上一步\n")
+
+	return s.String()
+}
+
+// languageSummary formats a language as "英语 - 流利 (IELTS 7.5)"
+func languageSummary(lang models.Language) string {
+	level := lang.Level
+	if label, ok := optionLabels[strings.ToLower(level)]; ok {
+		level = label
+	}
+	summary := fmt.Sprintf("%s - %s", lang.Name, level)
+	if lang.Certification != "" {
+		summary += fmt.Sprintf(" (%s)", lang.Certification)
+	}
+	return summary
+}
+
+// renderSectionManagement renders the custom section management interface
+func (m Model) renderSectionManagement() string {
+	var s strings.Builder
+
+	// Show progress bar
+	progress := m.calculateProgress()
+	stepName := m.getStepName()
+
+	s.WriteString(fmt.Sprintf("📋 简历创建进度 - %s (%.0f%%)\n", stepName, progress*100))
+	s.WriteString(m.progressBar.ViewAs(progress))
+	s.WriteString("\n\n")
+
+	s.WriteString("✨ 自定义章节管理\n\n")
+
+	if len(m.resume.Additional) == 0 {
+		s.WriteString("暂无自定义章节，如: 开源贡献、兴趣爱好 (可直接 Tab 跳过)\n\n")
+	} else {
+		s.WriteString("已有自定义章节:\n")
+		for i, section := range m.resume.Additional {
+			cursor := "  "
+			if i == m.selectedSection {
+				cursor = "▶ "
+			}
+			s.WriteString(fmt.Sprintf("%s%d. %s (%d 项)\n", cursor, i+1, section.Title, len(section.Items)))
+		}
+		s.WriteString("\n")
+	}
+
+	s.WriteString("选择操作:\n")
+	s.WriteString("  ↑/↓ 浏览章节列表\n")
+	s.WriteString("  Shift+↑/↓ 调整选中章节的顺序\n")
+	s.WriteString("  Enter 编辑选中的章节\n")
+	s.WriteString("  N 添加新的章节\n")
+	s.WriteString("  D 删除选中的章节\n")
+	s.WriteString("  Tab 继续下一步\n")
+	s.WriteString("  Esc 返回上一步\n")
+
+	return s.String()
+}
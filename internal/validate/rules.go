@@ -0,0 +1,146 @@
+package validate
+
+import (
+	"errors"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Field rules shared by Resume and the interactive editor. A rule returns nil
+// for a valid or empty value; whether a field is required is checked apart.
+
+// Rule checks the text of a single field
+type Rule func(value string) error
+
+// Errors returned by the rules, so callers can word their own messages
+var (
+	// ErrMonth is returned by Month for text that is not a valid YYYY-MM month
+	ErrMonth = errors.New("must be a month as YYYY-MM")
+	// ErrYear is returned by Year for text that is not a four-digit year
+	ErrYear = errors.New("must be a four-digit year")
+	// ErrYearRange is returned for years before MinYear or after MaxYear
+	ErrYearRange = errors.New("year is out of range")
+	// ErrEmail is returned by Email for text that is not a bare email address
+	ErrEmail = errors.New("must be an email address such as name@example.com")
+	// ErrPhone is returned by Phone for text that is not a phone number
+	ErrPhone = errors.New("must be a phone number of 7 to 15 digits")
+	// ErrURL is returned by URL for text that is not a web address
+	ErrURL = errors.New("must be a web address such as example.com")
+	// ErrProfile is returned by Profile for text that is neither a username nor a web address
+	ErrProfile = errors.New("must be a username or a web address")
+)
+
+// MinYear is the earliest year accepted in dates
+const MinYear = 1950
+
+// MaxYear is the latest year accepted in dates, ten years from now to allow
+// expected graduation and expiry dates
+func MaxYear() int {
+	return time.Now().Year() + 10
+}
+
+// YearInRange checks that year lies between MinYear and MaxYear
+func YearInRange(year int) error {
+	if year < MinYear || year > MaxYear() {
+		return ErrYearRange
+	}
+	return nil
+}
+
+// Year checks a four-digit year such as "2020"
+func Year(value string) error {
+	if value == "" {
+		return nil
+	}
+	year, err := strconv.Atoi(value)
+	if err != nil || len(value) != 4 {
+		return ErrYear
+	}
+	return YearInRange(year)
+}
+
+// Month checks a year and month such as "2022-06"
+func Month(value string) error {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse("2006-01", value)
+	if err != nil {
+		return ErrMonth
+	}
+	return YearInRange(t.Year())
+}
+
+// Email checks a bare email address, without a display name
+func Email(value string) error {
+	if value == "" {
+		return nil
+	}
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value || !strings.Contains(value[strings.LastIndex(value, "@"):], ".") {
+		return ErrEmail
+	}
+	return nil
+}
+
+// Phone checks a phone number of 7 to 15 digits, optionally written with a
+// leading +, spaces, dashes, dots or parentheses
+func Phone(value string) error {
+	if value == "" {
+		return nil
+	}
+	digits := 0
+	for i, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '+' && i == 0:
+		case strings.ContainsRune(" -.()", r):
+		default:
+			return ErrPhone
+		}
+	}
+	if digits < 7 || digits > 15 {
+		return ErrPhone
+	}
+	return nil
+}
+
+// URL checks a web address; the scheme may be left out, as in "example.com"
+func URL(value string) error {
+	if value == "" {
+		return nil
+	}
+	if strings.ContainsAny(value, " \t") {
+		return ErrURL
+	}
+	if !strings.Contains(value, "://") {
+		value = "https://" + value
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ErrURL
+	}
+	if host := u.Hostname(); !strings.Contains(host, ".") && host != "localhost" {
+		return ErrURL
+	}
+	return nil
+}
+
+// Profile checks a profile such as GitHub or LinkedIn, given either as a bare
+// username like "zhangsan" or as a web address like "github.com/zhangsan"
+func Profile(value string) error {
+	if strings.ContainsAny(value, "./") {
+		return URL(value)
+	}
+	for _, r := range value {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return ErrProfile
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRules(t *testing.T) {
+	future := fmt.Sprint(MaxYear() + 1)
+	tests := []struct {
+		name  string
+		rule  Rule
+		value string
+		want  error
+	}{
+		{"month", Month, "2022-06", nil},
+		{"month empty", Month, "", nil},
+		{"month 13", Month, "2022-13", ErrMonth},
+		{"month single digit", Month, "2022-6", ErrMonth},
+		{"month year only", Month, "2022", ErrMonth},
+		{"month too early", Month, "1900-01", ErrYearRange},
+		{"month too late", Month, future + "-01", ErrYearRange},
+		{"year", Year, "2020", nil},
+		{"year two digits", Year, "20", ErrYear},
+		{"year text", Year, "next", ErrYear},
+		{"year too late", Year, future, ErrYearRange},
+		{"email", Email, "name@example.com", nil},
+		{"email no domain dot", Email, "name@example", ErrEmail},
+		{"email display name", Email, "Name <name@example.com>", ErrEmail},
+		{"email no at", Email, "example.com", ErrEmail},
+		{"phone", Phone, "138-0013-8000", nil},
+		{"phone international", Phone, "+1 (555) 123-4567", nil},
+		{"phone too short", Phone, "12345", ErrPhone},
+		{"phone letters", Phone, "555-CALL-NOW", ErrPhone},
+		{"phone plus inside", Phone, "555+1234567", ErrPhone},
+		{"url bare host", URL, "zhangsan.dev", nil},
+		{"url https", URL, "https://github.com/user/repo", nil},
+		{"url localhost", URL, "http://localhost:8080", nil},
+		{"url no dot", URL, "portfolio", ErrURL},
+		{"url ftp", URL, "ftp://example.com", ErrURL},
+		{"url space", URL, "example .com", ErrURL},
+		{"profile username", Profile, "zhang-san_1", nil},
+		{"profile url", Profile, "github.com/zhangsan", nil},
+		{"profile bad url", Profile, "github/zhangsan", ErrURL},
+		{"profile space", Profile, "zhang san", ErrProfile},
+		{"profile at", Profile, "@zhangsan", ErrProfile},
+	}
+	for _, tt := range tests {
+		if got := tt.rule(tt.value); got != tt.want {
+			t.Errorf("%s: rule(%q) = %v, want %v", tt.name, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestYearInRange(t *testing.T) {
+	for year, want := range map[int]error{
+		MinYear - 1:       ErrYearRange,
+		MinYear:           nil,
+		time.Now().Year(): nil,
+		MaxYear():         nil,
+		MaxYear() + 1:     ErrYearRange,
+	} {
+		if got := YearInRange(year); got != want {
+			t.Errorf("YearInRange(%d) = %v, want %v", year, got, want)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package validate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/loveRyujin/ResuGo/internal/models"
+	"github.com/loveRyujin/ResuGo/internal/timeline"
+	"gopkg.in/yaml.v3"
+)
+
+// Severity separates problems that make a resume invalid from suggestions
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Problem is one validation finding
+type Problem struct {
+	Severity Severity `json:"severity"`
+	Field    string   `json:"field"`
+	Message  string   `json:"message"`
+}
+
+// Options configures validation
+type Options struct {
+	Timeline timeline.Options
+}
+
+// Resume checks required fields, layout and timeline consistency
+func Resume(r *models.Resume, opts Options) []Problem {
+	var problems []Problem
+	required := func(field, value string) {
+		if strings.TrimSpace(value) == "" {
+			problems = append(problems, Problem{SeverityError, field, "is required"})
+		}
+	}
+	check := func(field, value string, rule Rule) {
+		if err := rule(strings.TrimSpace(value)); err != nil {
+			problems = append(problems, Problem{SeverityError, field, err.Error()})
+		}
+	}
+	date := func(field string, t time.Time) {
+		if t.IsZero() {
+			return
+		}
+		if err := YearInRange(t.Year()); err != nil {
+			problems = append(problems, Problem{SeverityError, field, fmt.Sprintf("year %d is not between %d and %d", t.Year(), MinYear, MaxYear())})
+		}
+	}
+
+	required("personal_info.name", r.PersonalInfo.Name)
+	required("personal_info.email", r.PersonalInfo.Email)
+	check("personal_info.email", r.PersonalInfo.Email, Email)
+	check("personal_info.phone", r.PersonalInfo.Phone, Phone)
+	check("personal_info.website", r.PersonalInfo.Website, URL)
+	check("personal_info.github", r.PersonalInfo.GitHub, Profile)
+	check("personal_info.linkedin", r.PersonalInfo.LinkedIn, Profile)
+	for i, link := range r.PersonalInfo.Links {
+		required(fmt.Sprintf("personal_info.links[%d].url", i), link.URL)
+		check(fmt.Sprintf("personal_info.links[%d].url", i), link.URL, URL)
+	}
+	if photo := r.PhotoPath(); photo != "" {
+		if _, err := os.Stat(photo); err != nil {
+			problems = append(problems, Problem{SeverityError, "personal_info.photo", "file not found: " + photo})
+		} else if ext := strings.ToLower(filepath.Ext(photo)); ext != ".jpg" && ext != ".jpeg" && ext != ".png" {
+			problems = append(problems, Problem{SeverityWarning, "personal_info.photo", "should be a JPEG or PNG image"})
+		}
+	}
+
+	for i, edu := range r.Education {
+		prefix := fmt.Sprintf("education[%d]", i)
+		required(prefix+".institution", edu.Institution)
+		required(prefix+".degree", edu.Degree)
+		if edu.StartDate.IsZero() {
+			problems = append(problems, Problem{SeverityError, prefix + ".start_date", "is required"})
+		}
+		date(prefix+".start_date", edu.StartDate)
+		date(prefix+".end_date", edu.EndDate)
+	}
+	for i, exp := range r.Experience {
+		prefix := fmt.Sprintf("experience[%d]", i)
+		required(prefix+".company", exp.Company)
+		if !exp.HasRoles() {
+			required(prefix+".position", exp.Position)
+			date(prefix+".start_date", exp.StartDate)
+			date(prefix+".end_date", exp.EndDate)
+			if exp.StartDate.IsZero() {
+				problems = append(problems, Problem{SeverityError, prefix + ".start_date", "is required"})
+			}
+			if !exp.Current && exp.EndDate.IsZero() && !exp.StartDate.IsZero() {
+				problems = append(problems, Problem{SeverityWarning, prefix + ".end_date", "missing; set current: true for an ongoing role"})
+			}
+			continue
+		}
+		for j, role := range exp.Roles {
+			rolePrefix := fmt.Sprintf("%s.roles[%d]", prefix, j)
+			required(rolePrefix+".position", role.Position)
+			date(rolePrefix+".start_date", role.StartDate)
+			date(rolePrefix+".end_date", role.EndDate)
+			if role.StartDate.IsZero() {
+				problems = append(problems, Problem{SeverityError, rolePrefix + ".start_date", "is required"})
+			}
+			if !role.Current && role.EndDate.IsZero() && !role.StartDate.IsZero() {
+				problems = append(problems, Problem{SeverityWarning, rolePrefix + ".end_date", "missing; set current: true for an ongoing role"})
+			}
+		}
+	}
+	for i, proj := range r.Projects {
+		prefix := fmt.Sprintf("projects[%d]", i)
+		required(prefix+".name", proj.Name)
+		date(prefix+".start_date", proj.StartDate)
+		date(prefix+".end_date", proj.EndDate)
+		check(prefix+".url", proj.URL, URL)
+		check(prefix+".repository", proj.Repository, URL)
+	}
+	skills := func(path string, list models.SkillList) {
+		for i, skill := range list {
+			prefix := fmt.Sprintf("%s[%d]", path, i)
+			required(prefix+".name", skill.Name)
+			if !models.ValidSkillLevel(skill.Level) {
+				problems = append(problems, Problem{SeverityError, prefix + ".level", fmt.Sprintf("%q is not 1-%d or one of %s", skill.Level, models.MaxSkillLevel, strings.Join(models.SkillLevelNames, ", "))})
+			}
+			if skill.Years < 0 {
+				problems = append(problems, Problem{SeverityError, prefix + ".years", "must not be negative"})
+			}
+		}
+	}
+	skills("skills.languages", r.Skills.Languages)
+	skills("skills.frameworks", r.Skills.Frameworks)
+	skills("skills.databases", r.Skills.Databases)
+	skills("skills.tools", r.Skills.Tools)
+	skills("skills.other", r.Skills.Other)
+	for i, category := range r.Skills.Custom {
+		skills(fmt.Sprintf("skills.custom[%d].items", i), category.Items)
+	}
+	for i, lang := range r.Languages {
+		prefix := fmt.Sprintf("languages[%d]", i)
+		required(prefix+".name", lang.Name)
+		if strings.TrimSpace(lang.Level) == "" {
+			problems = append(problems, Problem{SeverityError, prefix + ".level", "is required"})
+		} else if !models.ValidLanguageLevel(lang.Level) {
+			problems = append(problems, Problem{SeverityError, prefix + ".level", fmt.Sprintf("%q is not one of %s", lang.Level, strings.Join(models.LanguageLevels, ", "))})
+		}
+	}
+	for i, cert := range r.Certifications {
+		required(fmt.Sprintf("certifications[%d].name", i), cert.Name)
+		date(fmt.Sprintf("certifications[%d].date", i), cert.Date)
+		if !cert.Expires.IsZero() && cert.Expires.Before(cert.Date) {
+			problems = append(problems, Problem{SeverityError, fmt.Sprintf("certifications[%d].expires", i), "is before the date earned"})
+		}
+	}
+	for i, award := range r.Awards {
+		required(fmt.Sprintf("awards[%d].title", i), award.Title)
+	}
+	for i, pub := range r.Publications {
+		required(fmt.Sprintf("publications[%d].title", i), pub.Title)
+		if pub.Year != 0 {
+			date(fmt.Sprintf("publications[%d].year", i), time.Date(pub.Year, time.January, 1, 0, 0, 0, 0, time.UTC))
+		}
+	}
+	for i, v := range r.Volunteer {
+		prefix := fmt.Sprintf("volunteer[%d]", i)
+		required(prefix+".organization", v.Organization)
+		required(prefix+".role", v.Role)
+		date(prefix+".start_date", v.StartDate)
+		date(prefix+".end_date", v.EndDate)
+	}
+
+	if err := r.Layout.Validate(); err != nil {
+		problems = append(problems, Problem{SeverityError, "layout", err.Error()})
+	}
+
+	for _, w := range timeline.Check(r, opts.Timeline) {
+		problems = append(problems, Problem{SeverityWarning, w.Section, w.Message()})
+	}
+	return problems
+}
+
+// StrictParse reports fields in data that do not belong to the resume schema,
+// usually typos that would otherwise be silently dropped
+func StrictParse(data []byte) []Problem {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var resume models.Resume
+	if err := dec.Decode(&resume); err != nil {
+		var problems []Problem
+		if typeErr, ok := err.(*yaml.TypeError); ok {
+			for _, msg := range typeErr.Errors {
+				problems = append(problems, Problem{SeverityWarning, "yaml", msg})
+			}
+			return problems
+		}
+		return []Problem{{SeverityWarning, "yaml", err.Error()}}
+	}
+	return nil
+}
+
+// HasErrors reports whether any problem is an error
+func HasErrors(problems []Problem) bool {
+	for _, p := range problems {
+		if p.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
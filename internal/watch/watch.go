@@ -0,0 +1,85 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"sort"
+	"time"
+)
+
+// Watcher polls a set of files and reports changes once writes have settled.
+// Polling keeps it dependency-free and works with editors that replace files
+// on save instead of writing in place.
+type Watcher struct {
+	Interval time.Duration   // How often files are checked
+	Debounce time.Duration   // Quiet period required after the last change
+	Paths    func() []string // Files to watch, re-evaluated on every poll
+}
+
+// fileState identifies a version of a file
+type fileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+// New creates a watcher with sensible defaults for interactive editing
+func New(paths func() []string) *Watcher {
+	return &Watcher{
+		Interval: 250 * time.Millisecond,
+		Debounce: 300 * time.Millisecond,
+		Paths:    paths,
+	}
+}
+
+// Run blocks until ctx is done, calling onChange with the changed paths after
+// every burst of modifications.
+func (w *Watcher) Run(ctx context.Context, onChange func(changed []string)) error {
+	states := w.snapshot()
+	pending := make(map[string]bool)
+	var lastChange time.Time
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			current := w.snapshot()
+			for path, state := range current {
+				if states[path] != state {
+					pending[path] = true
+					lastChange = now
+				}
+			}
+			states = current
+
+			if len(pending) > 0 && now.Sub(lastChange) >= w.Debounce {
+				changed := make([]string, 0, len(pending))
+				for path := range pending {
+					changed = append(changed, path)
+				}
+				sort.Strings(changed)
+				pending = make(map[string]bool)
+				onChange(changed)
+				// Pick up files added or written by the callback itself
+				states = w.snapshot()
+			}
+		}
+	}
+}
+
+func (w *Watcher) snapshot() map[string]fileState {
+	states := make(map[string]fileState)
+	for _, path := range w.Paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			states[path] = fileState{}
+			continue
+		}
+		states[path] = fileState{exists: true, modTime: info.ModTime(), size: info.Size()}
+	}
+	return states
+}
@@ -0,0 +1,51 @@
+// Package templates embeds the starter resumes, config and cover letter used by "resumgo init".
+package templates
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//go:embed example.yaml config.yaml cover-letter.yaml profiles/*.yaml
+var files embed.FS
+
+// DefaultProfile is the profile used when none is requested
+const DefaultProfile = "example"
+
+// Profile returns the starter resume for the named profile
+func Profile(name string) ([]byte, error) {
+	path := "profiles/" + name + ".yaml"
+	if name == DefaultProfile {
+		path = "example.yaml"
+	}
+	data, err := files.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unknown profile %q (available: %s)", name, strings.Join(Profiles(), ", "))
+	}
+	return data, nil
+}
+
+// Profiles lists the available profile names
+func Profiles() []string {
+	names := []string{DefaultProfile}
+	entries, _ := files.ReadDir("profiles")
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".yaml"))
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// Config returns the commented starter project config
+func Config() []byte {
+	data, _ := files.ReadFile("config.yaml")
+	return data
+}
+
+// CoverLetter returns the commented starter cover letter
+func CoverLetter() []byte {
+	data, _ := files.ReadFile("cover-letter.yaml")
+	return data
+}